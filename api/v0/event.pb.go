@@ -108,17 +108,33 @@ type FileEventType int32
 const (
 	// The type of event is unknown
 	FileEventType_FILE_EVENT_TYPE_UNKNOWN FileEventType = 0
-	// The event is a file open event
+	// The event is a file open event. Writes to files are observed as
+	// open events whose open_flags include O_WRONLY or O_RDWR (i.e.
+	// filtering on "flags & 3"). Individual write(2) calls are not
+	// reported, since the file written to can't be named from a
+	// kernel probe on write.
 	FileEventType_FILE_EVENT_TYPE_OPEN FileEventType = 1
+	// The event is a file unlink event
+	FileEventType_FILE_EVENT_TYPE_UNLINK FileEventType = 2
+	// The event is a file rename event
+	FileEventType_FILE_EVENT_TYPE_RENAME FileEventType = 3
+	// The event is a file permissions change event
+	FileEventType_FILE_EVENT_TYPE_CHMOD FileEventType = 4
 )
 
 var FileEventType_name = map[int32]string{
 	0: "FILE_EVENT_TYPE_UNKNOWN",
 	1: "FILE_EVENT_TYPE_OPEN",
+	2: "FILE_EVENT_TYPE_UNLINK",
+	3: "FILE_EVENT_TYPE_RENAME",
+	4: "FILE_EVENT_TYPE_CHMOD",
 }
 var FileEventType_value = map[string]int32{
 	"FILE_EVENT_TYPE_UNKNOWN": 0,
 	"FILE_EVENT_TYPE_OPEN":    1,
+	"FILE_EVENT_TYPE_UNLINK":  2,
+	"FILE_EVENT_TYPE_RENAME":  3,
+	"FILE_EVENT_TYPE_CHMOD":   4,
 }
 
 func (x FileEventType) String() string {
@@ -187,6 +203,13 @@ const (
 	// The event is the result of an attempt to receive data from a
 	// specific address
 	NetworkEventType_NETWORK_EVENT_TYPE_RECVFROM_RESULT NetworkEventType = 12
+	// The event is an attempt to send a DNS query to a name server.
+	// Only queries sent by sendto(2), sendmsg(2) or sendmmsg(2) with an
+	// explicit destination on port 53 are reported. Queries sent on a
+	// connected socket, as the glibc resolver does, carry no destination
+	// address and are not reported; subscribe to connect attempts to
+	// port 53 to observe those.
+	NetworkEventType_NETWORK_EVENT_TYPE_DNS_QUERY NetworkEventType = 13
 )
 
 var NetworkEventType_name = map[int32]string{
@@ -203,6 +226,7 @@ var NetworkEventType_name = map[int32]string{
 	10: "NETWORK_EVENT_TYPE_SENDTO_RESULT",
 	11: "NETWORK_EVENT_TYPE_RECVFROM_ATTEMPT",
 	12: "NETWORK_EVENT_TYPE_RECVFROM_RESULT",
+	13: "NETWORK_EVENT_TYPE_DNS_QUERY",
 }
 var NetworkEventType_value = map[string]int32{
 	"NETWORK_EVENT_TYPE_UNKNOWN":          0,
@@ -218,6 +242,7 @@ var NetworkEventType_value = map[string]int32{
 	"NETWORK_EVENT_TYPE_SENDTO_RESULT":    10,
 	"NETWORK_EVENT_TYPE_RECVFROM_ATTEMPT": 11,
 	"NETWORK_EVENT_TYPE_RECVFROM_RESULT":  12,
+	"NETWORK_EVENT_TYPE_DNS_QUERY":        13,
 }
 
 func (x NetworkEventType) String() string {
@@ -225,6 +250,117 @@ func (x NetworkEventType) String() string {
 }
 func (NetworkEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
 
+// Possible KernelLoadEvent types
+type KernelLoadEventType int32
+
+const (
+	// The type of event is unknown
+	KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_UNKNOWN KernelLoadEventType = 0
+	// The event is a kernel module being loaded via init_module(2) or
+	// finit_module(2).
+	KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_MODULE KernelLoadEventType = 1
+	// The event is a call to bpf(2).
+	KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_BPF KernelLoadEventType = 2
+)
+
+var KernelLoadEventType_name = map[int32]string{
+	0: "KERNEL_LOAD_EVENT_TYPE_UNKNOWN",
+	1: "KERNEL_LOAD_EVENT_TYPE_MODULE",
+	2: "KERNEL_LOAD_EVENT_TYPE_BPF",
+}
+var KernelLoadEventType_value = map[string]int32{
+	"KERNEL_LOAD_EVENT_TYPE_UNKNOWN": 0,
+	"KERNEL_LOAD_EVENT_TYPE_MODULE":  1,
+	"KERNEL_LOAD_EVENT_TYPE_BPF":     2,
+}
+
+func (x KernelLoadEventType) String() string {
+	return proto.EnumName(KernelLoadEventType_name, int32(x))
+}
+func (KernelLoadEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
+
+// Possible PrivilegeEvent types
+type PrivilegeEventType int32
+
+const (
+	// The type of event is unknown
+	PrivilegeEventType_PRIVILEGE_EVENT_TYPE_UNKNOWN PrivilegeEventType = 0
+	// The event is a call to setuid(2), setreuid(2), setresuid(2), or
+	// setfsuid(2).
+	PrivilegeEventType_PRIVILEGE_EVENT_TYPE_SETUID PrivilegeEventType = 1
+	// The event is a call to setgid(2), setregid(2), setresgid(2), or
+	// setfsgid(2).
+	PrivilegeEventType_PRIVILEGE_EVENT_TYPE_SETGID PrivilegeEventType = 2
+	// The event is a call to capset(2).
+	PrivilegeEventType_PRIVILEGE_EVENT_TYPE_CAPSET PrivilegeEventType = 3
+	// The event is the execution of a program that grants privileges,
+	// such as sudo, su, or pkexec.
+	PrivilegeEventType_PRIVILEGE_EVENT_TYPE_EXEC PrivilegeEventType = 4
+	// The event is /etc/passwd, /etc/shadow, /etc/group, /etc/gshadow,
+	// or /etc/sudoers being opened for writing or replaced by a rename.
+	PrivilegeEventType_PRIVILEGE_EVENT_TYPE_ACCOUNT_FILE_MODIFY PrivilegeEventType = 5
+)
+
+var PrivilegeEventType_name = map[int32]string{
+	0: "PRIVILEGE_EVENT_TYPE_UNKNOWN",
+	1: "PRIVILEGE_EVENT_TYPE_SETUID",
+	2: "PRIVILEGE_EVENT_TYPE_SETGID",
+	3: "PRIVILEGE_EVENT_TYPE_CAPSET",
+	4: "PRIVILEGE_EVENT_TYPE_EXEC",
+	5: "PRIVILEGE_EVENT_TYPE_ACCOUNT_FILE_MODIFY",
+}
+var PrivilegeEventType_value = map[string]int32{
+	"PRIVILEGE_EVENT_TYPE_UNKNOWN":             0,
+	"PRIVILEGE_EVENT_TYPE_SETUID":              1,
+	"PRIVILEGE_EVENT_TYPE_SETGID":              2,
+	"PRIVILEGE_EVENT_TYPE_CAPSET":              3,
+	"PRIVILEGE_EVENT_TYPE_EXEC":                4,
+	"PRIVILEGE_EVENT_TYPE_ACCOUNT_FILE_MODIFY": 5,
+}
+
+func (x PrivilegeEventType) String() string {
+	return proto.EnumName(PrivilegeEventType_name, int32(x))
+}
+func (PrivilegeEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+
+// Possible MemoryEvent types
+type MemoryEventType int32
+
+const (
+	// The type of event is unknown
+	MemoryEventType_MEMORY_EVENT_TYPE_UNKNOWN MemoryEventType = 0
+	// The event is a call to mprotect(2) or pkey_mprotect(2) that
+	// requests PROT_EXEC. Whether the region was writable before the
+	// call isn't known, since only the syscall arguments are seen, so
+	// loaders and JITs remapping code read-only are reported too. A
+	// filter expression of "prot & 2" reports only requests that make
+	// memory writable and executable at once.
+	MemoryEventType_MEMORY_EVENT_TYPE_MPROTECT_EXEC MemoryEventType = 1
+	// The event is a call to ptrace(2) that attaches to another
+	// process or modifies its memory or registers.
+	MemoryEventType_MEMORY_EVENT_TYPE_PTRACE MemoryEventType = 2
+	// The event is a call to process_vm_writev(2).
+	MemoryEventType_MEMORY_EVENT_TYPE_PROCESS_VM_WRITEV MemoryEventType = 3
+)
+
+var MemoryEventType_name = map[int32]string{
+	0: "MEMORY_EVENT_TYPE_UNKNOWN",
+	1: "MEMORY_EVENT_TYPE_MPROTECT_EXEC",
+	2: "MEMORY_EVENT_TYPE_PTRACE",
+	3: "MEMORY_EVENT_TYPE_PROCESS_VM_WRITEV",
+}
+var MemoryEventType_value = map[string]int32{
+	"MEMORY_EVENT_TYPE_UNKNOWN":           0,
+	"MEMORY_EVENT_TYPE_MPROTECT_EXEC":     1,
+	"MEMORY_EVENT_TYPE_PTRACE":            2,
+	"MEMORY_EVENT_TYPE_PROCESS_VM_WRITEV": 3,
+}
+
+func (x MemoryEventType) String() string {
+	return proto.EnumName(MemoryEventType_name, int32(x))
+}
+func (MemoryEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
+
 // Possible field types
 type KernelFunctionCallEvent_FieldType int32
 
@@ -284,7 +420,7 @@ func (x KernelFunctionCallEvent_FieldType) String() string {
 	return proto.EnumName(KernelFunctionCallEvent_FieldType_name, int32(x))
 }
 func (KernelFunctionCallEvent_FieldType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{8, 0}
+	return fileDescriptor1, []int{9, 0}
 }
 
 // An event observed by the Sensor.
@@ -315,6 +451,10 @@ type Event struct {
 	// hierarchy, starting with the current process, up to the root of the
 	// process namespace.
 	ProcessLineage []*Process `protobuf:"bytes,8,rep,name=process_lineage,json=processLineage" json:"process_lineage,omitempty"`
+	// Version of the event schema that the Sensor was built with (see
+	// GetSchema). A value of zero means the event was generated by a
+	// Sensor that predates schema versioning.
+	SchemaVersion uint32 `protobuf:"varint,9,opt,name=schema_version,json=schemaVersion" json:"schema_version,omitempty"`
 	// Name of container associated with the event
 	ContainerName string `protobuf:"bytes,30,opt,name=container_name,json=containerName" json:"container_name,omitempty"`
 	// Unique identifier of the container image
@@ -324,13 +464,29 @@ type Event struct {
 	// "gcr.io/google_containers/nginx-ingress-controller")
 	//
 	ImageName string `protobuf:"bytes,32,opt,name=image_name,json=imageName" json:"image_name,omitempty"`
+	// Kubernetes metadata of the container associated with the event,
+	// if the container belongs to a Kubernetes pod and the Sensor is
+	// configured to report it
+	Kubernetes *KubernetesMetadata `protobuf:"bytes,33,opt,name=kubernetes" json:"kubernetes,omitempty"`
+	// If greater than one, the number of identical events that were
+	// merged into this one by a CoalesceModifier. sensor_monotime_nanos
+	// is then the time of the first of them, and
+	// last_sensor_monotime_nanos the time of the last.
+	CoalescedCount          uint64 `protobuf:"varint,34,opt,name=coalesced_count,json=coalescedCount" json:"coalesced_count,omitempty"`
+	LastSensorMonotimeNanos int64  `protobuf:"varint,35,opt,name=last_sensor_monotime_nanos,json=lastSensorMonotimeNanos" json:"last_sensor_monotime_nanos,omitempty"`
 	// Types that are valid to be assigned to Event:
 	//	*Event_Syscall
 	//	*Event_Process
 	//	*Event_File
 	//	*Event_KernelCall
 	//	*Event_Network
+	//	*Event_KernelLoad
+	//	*Event_Privilege
+	//	*Event_Memory
 	//	*Event_Container
+	//	*Event_Alert
+	//	*Event_Metrics
+	//	*Event_LostEvents
 	//	*Event_Chargen
 	//	*Event_Ticker
 	Event isEvent_Event `protobuf_oneof:"event"`
@@ -362,9 +518,27 @@ type Event_KernelCall struct {
 type Event_Network struct {
 	Network *NetworkEvent `protobuf:"bytes,14,opt,name=network,oneof"`
 }
+type Event_KernelLoad struct {
+	KernelLoad *KernelLoadEvent `protobuf:"bytes,15,opt,name=kernel_load,json=kernelLoad,oneof"`
+}
+type Event_Privilege struct {
+	Privilege *PrivilegeEvent `protobuf:"bytes,16,opt,name=privilege,oneof"`
+}
+type Event_Memory struct {
+	Memory *MemoryEvent `protobuf:"bytes,17,opt,name=memory,oneof"`
+}
 type Event_Container struct {
 	Container *ContainerEvent `protobuf:"bytes,20,opt,name=container,oneof"`
 }
+type Event_Alert struct {
+	Alert *AlertEvent `protobuf:"bytes,50,opt,name=alert,oneof"`
+}
+type Event_Metrics struct {
+	Metrics *SensorMetricsEvent `protobuf:"bytes,51,opt,name=metrics,oneof"`
+}
+type Event_LostEvents struct {
+	LostEvents *LostEventsEvent `protobuf:"bytes,52,opt,name=lost_events,json=lostEvents,oneof"`
+}
 type Event_Chargen struct {
 	Chargen *ChargenEvent `protobuf:"bytes,100,opt,name=chargen,oneof"`
 }
@@ -377,7 +551,13 @@ func (*Event_Process) isEvent_Event()    {}
 func (*Event_File) isEvent_Event()       {}
 func (*Event_KernelCall) isEvent_Event() {}
 func (*Event_Network) isEvent_Event()    {}
+func (*Event_KernelLoad) isEvent_Event() {}
+func (*Event_Privilege) isEvent_Event()  {}
+func (*Event_Memory) isEvent_Event()     {}
 func (*Event_Container) isEvent_Event()  {}
+func (*Event_Alert) isEvent_Event()      {}
+func (*Event_Metrics) isEvent_Event()    {}
+func (*Event_LostEvents) isEvent_Event() {}
 func (*Event_Chargen) isEvent_Event()    {}
 func (*Event_Ticker) isEvent_Event()     {}
 
@@ -444,6 +624,13 @@ func (m *Event) GetProcessLineage() []*Process {
 	return nil
 }
 
+func (m *Event) GetSchemaVersion() uint32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
 func (m *Event) GetContainerName() string {
 	if m != nil {
 		return m.ContainerName
@@ -465,6 +652,27 @@ func (m *Event) GetImageName() string {
 	return ""
 }
 
+func (m *Event) GetKubernetes() *KubernetesMetadata {
+	if m != nil {
+		return m.Kubernetes
+	}
+	return nil
+}
+
+func (m *Event) GetCoalescedCount() uint64 {
+	if m != nil {
+		return m.CoalescedCount
+	}
+	return 0
+}
+
+func (m *Event) GetLastSensorMonotimeNanos() int64 {
+	if m != nil {
+		return m.LastSensorMonotimeNanos
+	}
+	return 0
+}
+
 func (m *Event) GetSyscall() *SyscallEvent {
 	if x, ok := m.GetEvent().(*Event_Syscall); ok {
 		return x.Syscall
@@ -500,6 +708,27 @@ func (m *Event) GetNetwork() *NetworkEvent {
 	return nil
 }
 
+func (m *Event) GetKernelLoad() *KernelLoadEvent {
+	if x, ok := m.GetEvent().(*Event_KernelLoad); ok {
+		return x.KernelLoad
+	}
+	return nil
+}
+
+func (m *Event) GetPrivilege() *PrivilegeEvent {
+	if x, ok := m.GetEvent().(*Event_Privilege); ok {
+		return x.Privilege
+	}
+	return nil
+}
+
+func (m *Event) GetMemory() *MemoryEvent {
+	if x, ok := m.GetEvent().(*Event_Memory); ok {
+		return x.Memory
+	}
+	return nil
+}
+
 func (m *Event) GetContainer() *ContainerEvent {
 	if x, ok := m.GetEvent().(*Event_Container); ok {
 		return x.Container
@@ -507,6 +736,27 @@ func (m *Event) GetContainer() *ContainerEvent {
 	return nil
 }
 
+func (m *Event) GetAlert() *AlertEvent {
+	if x, ok := m.GetEvent().(*Event_Alert); ok {
+		return x.Alert
+	}
+	return nil
+}
+
+func (m *Event) GetMetrics() *SensorMetricsEvent {
+	if x, ok := m.GetEvent().(*Event_Metrics); ok {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (m *Event) GetLostEvents() *LostEventsEvent {
+	if x, ok := m.GetEvent().(*Event_LostEvents); ok {
+		return x.LostEvents
+	}
+	return nil
+}
+
 func (m *Event) GetChargen() *ChargenEvent {
 	if x, ok := m.GetEvent().(*Event_Chargen); ok {
 		return x.Chargen
@@ -536,7 +786,13 @@ func (*Event) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error,
 		(*Event_File)(nil),
 		(*Event_KernelCall)(nil),
 		(*Event_Network)(nil),
+		(*Event_KernelLoad)(nil),
+		(*Event_Privilege)(nil),
+		(*Event_Memory)(nil),
 		(*Event_Container)(nil),
+		(*Event_Alert)(nil),
+		(*Event_Metrics)(nil),
+		(*Event_LostEvents)(nil),
 		(*Event_Chargen)(nil),
 		(*Event_Ticker)(nil),
 	}
@@ -571,11 +827,41 @@ func _Event_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Network); err != nil {
 			return err
 		}
+	case *Event_KernelLoad:
+		b.EncodeVarint(15<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.KernelLoad); err != nil {
+			return err
+		}
+	case *Event_Privilege:
+		b.EncodeVarint(16<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Privilege); err != nil {
+			return err
+		}
+	case *Event_Memory:
+		b.EncodeVarint(17<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Memory); err != nil {
+			return err
+		}
 	case *Event_Container:
 		b.EncodeVarint(20<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.Container); err != nil {
 			return err
 		}
+	case *Event_Alert:
+		b.EncodeVarint(50<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Alert); err != nil {
+			return err
+		}
+	case *Event_Metrics:
+		b.EncodeVarint(51<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Metrics); err != nil {
+			return err
+		}
+	case *Event_LostEvents:
+		b.EncodeVarint(52<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LostEvents); err != nil {
+			return err
+		}
 	case *Event_Chargen:
 		b.EncodeVarint(100<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.Chargen); err != nil {
@@ -636,6 +922,30 @@ func _Event_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer)
 		err := b.DecodeMessage(msg)
 		m.Event = &Event_Network{msg}
 		return true, err
+	case 15: // event.kernel_load
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(KernelLoadEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &Event_KernelLoad{msg}
+		return true, err
+	case 16: // event.privilege
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PrivilegeEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &Event_Privilege{msg}
+		return true, err
+	case 17: // event.memory
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MemoryEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &Event_Memory{msg}
+		return true, err
 	case 20: // event.container
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -644,6 +954,30 @@ func _Event_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer)
 		err := b.DecodeMessage(msg)
 		m.Event = &Event_Container{msg}
 		return true, err
+	case 50: // event.alert
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(AlertEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &Event_Alert{msg}
+		return true, err
+	case 51: // event.metrics
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SensorMetricsEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &Event_Metrics{msg}
+		return true, err
+	case 52: // event.lost_events
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(LostEventsEvent)
+		err := b.DecodeMessage(msg)
+		m.Event = &Event_LostEvents{msg}
+		return true, err
 	case 100: // event.chargen
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -694,11 +1028,41 @@ func _Event_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(14<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Event_KernelLoad:
+		s := proto.Size(x.KernelLoad)
+		n += proto.SizeVarint(15<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Event_Privilege:
+		s := proto.Size(x.Privilege)
+		n += proto.SizeVarint(16<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Event_Memory:
+		s := proto.Size(x.Memory)
+		n += proto.SizeVarint(17<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *Event_Container:
 		s := proto.Size(x.Container)
 		n += proto.SizeVarint(20<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Event_Alert:
+		s := proto.Size(x.Alert)
+		n += proto.SizeVarint(50<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Event_Metrics:
+		s := proto.Size(x.Metrics)
+		n += proto.SizeVarint(51<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Event_LostEvents:
+		s := proto.Size(x.LostEvents)
+		n += proto.SizeVarint(52<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *Event_Chargen:
 		s := proto.Size(x.Chargen)
 		n += proto.SizeVarint(100<<3 | proto.WireBytes)
@@ -992,6 +1356,8 @@ type SyscallEvent struct {
 	Type SyscallEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.SyscallEventType" json:"type,omitempty"`
 	// The syscall number for either enter or exit events.
 	Id int64 `protobuf:"varint,2,opt,name=id" json:"id,omitempty"`
+	// The syscall name for either enter or exit events, if known.
+	Name string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
 	// Present when the event is an enter event. This is the first
 	// argument passed to the system call.
 	Arg0 uint64 `protobuf:"varint,10,opt,name=arg0" json:"arg0,omitempty"`
@@ -1034,6 +1400,13 @@ func (m *SyscallEvent) GetId() int64 {
 	return 0
 }
 
+func (m *SyscallEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 func (m *SyscallEvent) GetArg0() uint64 {
 	if m != nil {
 		return m.Arg0
@@ -1088,8 +1461,9 @@ func (m *SyscallEvent) GetRet() int64 {
 type FileEvent struct {
 	// The type of event described by this FileEvent message
 	Type FileEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.FileEventType" json:"type,omitempty"`
-	// Present when the event is a file open event. This is the filename of
-	// the file being opened.
+	// Present for all file events. This is the filename of the file being
+	// opened, unlinked, or changed. For rename events, this is the
+	// original filename.
 	Filename string `protobuf:"bytes,10,opt,name=filename" json:"filename,omitempty"`
 	// Present when the event is a file open event. This is the set of
 	// flags with which the file was opened (e.g., O_RDONLY, O_NONBLOCK,
@@ -1098,6 +1472,12 @@ type FileEvent struct {
 	// Present when the event is a file open event. This is the set of file
 	// permissions used in a creat(2) system call.
 	OpenMode int32 `protobuf:"zigzag32,12,opt,name=open_mode,json=openMode" json:"open_mode,omitempty"`
+	// Present when the event is a file rename event. This is the new
+	// filename of the file being renamed.
+	RenameNewFilename string `protobuf:"bytes,20,opt,name=rename_new_filename,json=renameNewFilename" json:"rename_new_filename,omitempty"`
+	// Present when the event is a file permissions change event. This is
+	// the set of file permissions passed to chmod(2).
+	ChmodMode int32 `protobuf:"zigzag32,30,opt,name=chmod_mode,json=chmodMode" json:"chmod_mode,omitempty"`
 }
 
 func (m *FileEvent) Reset()                    { *m = FileEvent{} }
@@ -1133,15 +1513,92 @@ func (m *FileEvent) GetOpenMode() int32 {
 	return 0
 }
 
+func (m *FileEvent) GetRenameNewFilename() string {
+	if m != nil {
+		return m.RenameNewFilename
+	}
+	return ""
+}
+
+func (m *FileEvent) GetChmodMode() int32 {
+	if m != nil {
+		return m.ChmodMode
+	}
+	return 0
+}
+
+// KubernetesMetadata describes the Kubernetes pod that a container belongs
+// to, as labeled by the kubelet.
+type KubernetesMetadata struct {
+	// Name of the pod
+	PodName string `protobuf:"bytes,1,opt,name=pod_name,json=podName" json:"pod_name,omitempty"`
+	// Namespace of the pod
+	PodNamespace string `protobuf:"bytes,2,opt,name=pod_namespace,json=podNamespace" json:"pod_namespace,omitempty"`
+	// Unique identifier of the pod
+	PodUid string `protobuf:"bytes,3,opt,name=pod_uid,json=podUid" json:"pod_uid,omitempty"`
+	// Name of the container within the pod
+	ContainerName string `protobuf:"bytes,4,opt,name=container_name,json=containerName" json:"container_name,omitempty"`
+	// Labels of the pod
+	PodLabels map[string]string `protobuf:"bytes,5,rep,name=pod_labels,json=podLabels" json:"pod_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *KubernetesMetadata) Reset()                    { *m = KubernetesMetadata{} }
+func (m *KubernetesMetadata) String() string            { return proto.CompactTextString(m) }
+func (*KubernetesMetadata) ProtoMessage()               {}
+func (*KubernetesMetadata) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+
+func (m *KubernetesMetadata) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *KubernetesMetadata) GetPodNamespace() string {
+	if m != nil {
+		return m.PodNamespace
+	}
+	return ""
+}
+
+func (m *KubernetesMetadata) GetPodUid() string {
+	if m != nil {
+		return m.PodUid
+	}
+	return ""
+}
+
+func (m *KubernetesMetadata) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *KubernetesMetadata) GetPodLabels() map[string]string {
+	if m != nil {
+		return m.PodLabels
+	}
+	return nil
+}
+
 type Process struct {
 	Pid     int32  `protobuf:"zigzag32,1,opt,name=pid" json:"pid,omitempty"`
 	Command string `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	// Unique process identifier for the process, as in
+	// Event.process_id
+	ProcessId string `protobuf:"bytes,3,opt,name=process_id,json=processId" json:"process_id,omitempty"`
+	// The leading elements of argv passed to execve(2) when the
+	// process was last exec'd, if known.
+	CommandLine []string `protobuf:"bytes,4,rep,name=command_line,json=commandLine" json:"command_line,omitempty"`
+	// Container identifier associated with the process, if any
+	ContainerId string `protobuf:"bytes,5,opt,name=container_id,json=containerId" json:"container_id,omitempty"`
 }
 
 func (m *Process) Reset()                    { *m = Process{} }
 func (m *Process) String() string            { return proto.CompactTextString(m) }
 func (*Process) ProtoMessage()               {}
-func (*Process) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+func (*Process) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
 
 func (m *Process) GetPid() int32 {
 	if m != nil {
@@ -1157,6 +1614,27 @@ func (m *Process) GetCommand() string {
 	return ""
 }
 
+func (m *Process) GetProcessId() string {
+	if m != nil {
+		return m.ProcessId
+	}
+	return ""
+}
+
+func (m *Process) GetCommandLine() []string {
+	if m != nil {
+		return m.CommandLine
+	}
+	return nil
+}
+
+func (m *Process) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
 // KernelFunctionCallEvent describes an event that occurred related to kernel
 // functions being entered or exited.
 type KernelFunctionCallEvent struct {
@@ -1170,7 +1648,7 @@ type KernelFunctionCallEvent struct {
 func (m *KernelFunctionCallEvent) Reset()                    { *m = KernelFunctionCallEvent{} }
 func (m *KernelFunctionCallEvent) String() string            { return proto.CompactTextString(m) }
 func (*KernelFunctionCallEvent) ProtoMessage()               {}
-func (*KernelFunctionCallEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
+func (*KernelFunctionCallEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
 
 func (m *KernelFunctionCallEvent) GetArguments() map[string]*KernelFunctionCallEvent_FieldValue {
 	if m != nil {
@@ -1196,7 +1674,7 @@ func (m *KernelFunctionCallEvent_FieldValue) Reset()         { *m = KernelFuncti
 func (m *KernelFunctionCallEvent_FieldValue) String() string { return proto.CompactTextString(m) }
 func (*KernelFunctionCallEvent_FieldValue) ProtoMessage()    {}
 func (*KernelFunctionCallEvent_FieldValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{8, 0}
+	return fileDescriptor1, []int{9, 0}
 }
 
 type isKernelFunctionCallEvent_FieldValue_Value interface {
@@ -1378,12 +1856,15 @@ type NetworkEvent struct {
 	// Present only when the event describes a listen attempt. This is the
 	// value of the backlog argument passed to listen(2).
 	Backlog uint64 `protobuf:"varint,13,opt,name=backlog" json:"backlog,omitempty"`
+	// Present only when the event describes a DNS query. This is the
+	// domain name being queried (e.g. "www.example.com").
+	DnsQueryName string `protobuf:"bytes,14,opt,name=dns_query_name,json=dnsQueryName" json:"dns_query_name,omitempty"`
 }
 
 func (m *NetworkEvent) Reset()                    { *m = NetworkEvent{} }
 func (m *NetworkEvent) String() string            { return proto.CompactTextString(m) }
 func (*NetworkEvent) ProtoMessage()               {}
-func (*NetworkEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
+func (*NetworkEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
 
 func (m *NetworkEvent) GetType() NetworkEventType {
 	if m != nil {
@@ -1420,6 +1901,521 @@ func (m *NetworkEvent) GetBacklog() uint64 {
 	return 0
 }
 
+func (m *NetworkEvent) GetDnsQueryName() string {
+	if m != nil {
+		return m.DnsQueryName
+	}
+	return ""
+}
+
+// KernelLoadEvent describes code being loaded into the running kernel,
+// either as a kernel module or as a BPF program.
+type KernelLoadEvent struct {
+	// The type of event described by this KernelLoadEvent message.
+	Type KernelLoadEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.KernelLoadEventType" json:"type,omitempty"`
+	// Present when the event describes a module load. This is the name
+	// of the module that was loaded. The path of the module file is not
+	// reported, since init_module(2) loads modules from memory.
+	ModuleName string `protobuf:"bytes,10,opt,name=module_name,json=moduleName" json:"module_name,omitempty"`
+	// Present when the event describes a module load. This is the set
+	// of taint flags the module applies to the kernel.
+	ModuleTaints uint32 `protobuf:"varint,11,opt,name=module_taints,json=moduleTaints" json:"module_taints,omitempty"`
+	// Present when the event describes a bpf(2) call. This is the cmd
+	// argument passed to bpf(2) (e.g. BPF_PROG_LOAD).
+	BpfCmd int32 `protobuf:"varint,20,opt,name=bpf_cmd,json=bpfCmd" json:"bpf_cmd,omitempty"`
+	// Present only when the event describes a BPF_PROG_LOAD command.
+	// This is the type of the program being loaded.
+	BpfProgType uint32 `protobuf:"varint,21,opt,name=bpf_prog_type,json=bpfProgType" json:"bpf_prog_type,omitempty"`
+	// Present only when the event describes a BPF_PROG_LOAD command.
+	// This is the number of instructions in the program being loaded.
+	BpfInsnCnt uint32 `protobuf:"varint,22,opt,name=bpf_insn_cnt,json=bpfInsnCnt" json:"bpf_insn_cnt,omitempty"`
+	// Present only when the event describes a BPF_PROG_LOAD command on
+	// kernels that support naming programs. This is the program name.
+	BpfProgName string `protobuf:"bytes,23,opt,name=bpf_prog_name,json=bpfProgName" json:"bpf_prog_name,omitempty"`
+}
+
+func (m *KernelLoadEvent) Reset()                    { *m = KernelLoadEvent{} }
+func (m *KernelLoadEvent) String() string            { return proto.CompactTextString(m) }
+func (*KernelLoadEvent) ProtoMessage()               {}
+func (*KernelLoadEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
+
+func (m *KernelLoadEvent) GetType() KernelLoadEventType {
+	if m != nil {
+		return m.Type
+	}
+	return KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_UNKNOWN
+}
+
+func (m *KernelLoadEvent) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *KernelLoadEvent) GetModuleTaints() uint32 {
+	if m != nil {
+		return m.ModuleTaints
+	}
+	return 0
+}
+
+func (m *KernelLoadEvent) GetBpfCmd() int32 {
+	if m != nil {
+		return m.BpfCmd
+	}
+	return 0
+}
+
+func (m *KernelLoadEvent) GetBpfProgType() uint32 {
+	if m != nil {
+		return m.BpfProgType
+	}
+	return 0
+}
+
+func (m *KernelLoadEvent) GetBpfInsnCnt() uint32 {
+	if m != nil {
+		return m.BpfInsnCnt
+	}
+	return 0
+}
+
+func (m *KernelLoadEvent) GetBpfProgName() string {
+	if m != nil {
+		return m.BpfProgName
+	}
+	return ""
+}
+
+// PrivilegeEvent describes an attempt by a process to change its own
+// privileges or those granted to users. The acting process and its
+// container are identified by the enclosing Event.
+type PrivilegeEvent struct {
+	// The type of event described by this PrivilegeEvent message.
+	Type PrivilegeEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.PrivilegeEventType" json:"type,omitempty"`
+	// Present when the event describes a SETUID or SETGID event. This
+	// is the name of the system call that was made (e.g. "setresuid").
+	Syscall string `protobuf:"bytes,10,opt,name=syscall" json:"syscall,omitempty"`
+	// Present when the event describes a SETUID or SETGID event. These
+	// are the requested real, effective, saved, and filesystem ids. Ids
+	// that the system call doesn't set, or that it was asked to leave
+	// unchanged, are -1. The single argument of setuid(2) and setgid(2)
+	// is reported as the effective id.
+	RealId      int64 `protobuf:"zigzag64,11,opt,name=real_id,json=realId" json:"real_id,omitempty"`
+	EffectiveId int64 `protobuf:"zigzag64,12,opt,name=effective_id,json=effectiveId" json:"effective_id,omitempty"`
+	SavedId     int64 `protobuf:"zigzag64,13,opt,name=saved_id,json=savedId" json:"saved_id,omitempty"`
+	FsId        int64 `protobuf:"zigzag64,14,opt,name=fs_id,json=fsId" json:"fs_id,omitempty"`
+	// Present only when the event describes a CAPSET event. This is the
+	// pid whose capabilities are being set (0 for the calling thread).
+	CapPid int32 `protobuf:"zigzag32,20,opt,name=cap_pid,json=capPid" json:"cap_pid,omitempty"`
+	// Present only when the event describes a CAPSET event. These are
+	// the requested capability sets.
+	CapEffective   uint64 `protobuf:"varint,21,opt,name=cap_effective,json=capEffective" json:"cap_effective,omitempty"`
+	CapPermitted   uint64 `protobuf:"varint,22,opt,name=cap_permitted,json=capPermitted" json:"cap_permitted,omitempty"`
+	CapInheritable uint64 `protobuf:"varint,23,opt,name=cap_inheritable,json=capInheritable" json:"cap_inheritable,omitempty"`
+	// Present only when the event describes an EXEC event. This is the
+	// filename of the program and its command-line, as in ProcessEvent.
+	ExecFilename    string   `protobuf:"bytes,30,opt,name=exec_filename,json=execFilename" json:"exec_filename,omitempty"`
+	ExecCommandLine []string `protobuf:"bytes,31,rep,name=exec_command_line,json=execCommandLine" json:"exec_command_line,omitempty"`
+	// Present only when the event describes an ACCOUNT_FILE_MODIFY
+	// event. This is the account file being modified.
+	Filename string `protobuf:"bytes,40,opt,name=filename" json:"filename,omitempty"`
+	// Present only when the event describes an ACCOUNT_FILE_MODIFY
+	// event caused by open(2). These are the flags it was opened with.
+	OpenFlags int32 `protobuf:"varint,41,opt,name=open_flags,json=openFlags" json:"open_flags,omitempty"`
+	// Present only when the event describes an ACCOUNT_FILE_MODIFY
+	// event caused by rename(2). This is the file renamed over the
+	// account file.
+	RenameOldFilename string `protobuf:"bytes,42,opt,name=rename_old_filename,json=renameOldFilename" json:"rename_old_filename,omitempty"`
+}
+
+func (m *PrivilegeEvent) Reset()                    { *m = PrivilegeEvent{} }
+func (m *PrivilegeEvent) String() string            { return proto.CompactTextString(m) }
+func (*PrivilegeEvent) ProtoMessage()               {}
+func (*PrivilegeEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12} }
+
+func (m *PrivilegeEvent) GetType() PrivilegeEventType {
+	if m != nil {
+		return m.Type
+	}
+	return PrivilegeEventType_PRIVILEGE_EVENT_TYPE_UNKNOWN
+}
+
+func (m *PrivilegeEvent) GetSyscall() string {
+	if m != nil {
+		return m.Syscall
+	}
+	return ""
+}
+
+func (m *PrivilegeEvent) GetRealId() int64 {
+	if m != nil {
+		return m.RealId
+	}
+	return 0
+}
+
+func (m *PrivilegeEvent) GetEffectiveId() int64 {
+	if m != nil {
+		return m.EffectiveId
+	}
+	return 0
+}
+
+func (m *PrivilegeEvent) GetSavedId() int64 {
+	if m != nil {
+		return m.SavedId
+	}
+	return 0
+}
+
+func (m *PrivilegeEvent) GetFsId() int64 {
+	if m != nil {
+		return m.FsId
+	}
+	return 0
+}
+
+func (m *PrivilegeEvent) GetCapPid() int32 {
+	if m != nil {
+		return m.CapPid
+	}
+	return 0
+}
+
+func (m *PrivilegeEvent) GetCapEffective() uint64 {
+	if m != nil {
+		return m.CapEffective
+	}
+	return 0
+}
+
+func (m *PrivilegeEvent) GetCapPermitted() uint64 {
+	if m != nil {
+		return m.CapPermitted
+	}
+	return 0
+}
+
+func (m *PrivilegeEvent) GetCapInheritable() uint64 {
+	if m != nil {
+		return m.CapInheritable
+	}
+	return 0
+}
+
+func (m *PrivilegeEvent) GetExecFilename() string {
+	if m != nil {
+		return m.ExecFilename
+	}
+	return ""
+}
+
+func (m *PrivilegeEvent) GetExecCommandLine() []string {
+	if m != nil {
+		return m.ExecCommandLine
+	}
+	return nil
+}
+
+func (m *PrivilegeEvent) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *PrivilegeEvent) GetOpenFlags() int32 {
+	if m != nil {
+		return m.OpenFlags
+	}
+	return 0
+}
+
+func (m *PrivilegeEvent) GetRenameOldFilename() string {
+	if m != nil {
+		return m.RenameOldFilename
+	}
+	return ""
+}
+
+// MemoryEvent describes a process changing the protection of its own
+// memory or writing to the memory of another process, as done when
+// injecting code. The originating process and its lineage are described
+// by the enclosing Event.
+type MemoryEvent struct {
+	// The type of event described by this MemoryEvent message.
+	Type MemoryEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.MemoryEventType" json:"type,omitempty"`
+	// Present only when the event describes an MPROTECT_EXEC event.
+	// These are the start address and length of the memory region.
+	Address uint64 `protobuf:"varint,10,opt,name=address" json:"address,omitempty"`
+	Length  uint64 `protobuf:"varint,11,opt,name=length" json:"length,omitempty"`
+	// Present only when the event describes an MPROTECT_EXEC event.
+	// This is the requested protection (PROT_* flags). A protection
+	// that includes both PROT_WRITE and PROT_EXEC makes the region
+	// writable and executable at once.
+	Prot int32 `protobuf:"varint,12,opt,name=prot" json:"prot,omitempty"`
+	// Present when the event describes a PTRACE or PROCESS_VM_WRITEV
+	// event. This is the pid of the target process as passed to the
+	// system call, which is in the calling process's pid namespace.
+	TargetPid int32 `protobuf:"varint,20,opt,name=target_pid,json=targetPid" json:"target_pid,omitempty"`
+	// Present when the event describes a PTRACE or PROCESS_VM_WRITEV
+	// event, the calling process is in the host's pid namespace, and
+	// the target process is known to the Sensor. This is the unique
+	// process identifier of the target, as in Event.process_id.
+	TargetProcessId string `protobuf:"bytes,21,opt,name=target_process_id,json=targetProcessId" json:"target_process_id,omitempty"`
+	// Present only when the event describes a PTRACE event. These are
+	// the request (e.g. PTRACE_POKETEXT) and address arguments passed
+	// to ptrace(2).
+	PtraceRequest int64  `protobuf:"zigzag64,22,opt,name=ptrace_request,json=ptraceRequest" json:"ptrace_request,omitempty"`
+	PtraceAddress uint64 `protobuf:"varint,23,opt,name=ptrace_address,json=ptraceAddress" json:"ptrace_address,omitempty"`
+	// Present only when the event describes a PROCESS_VM_WRITEV event.
+	// These are the address of the first remote memory region written
+	// and the number of remote regions.
+	RemoteAddress  uint64 `protobuf:"varint,24,opt,name=remote_address,json=remoteAddress" json:"remote_address,omitempty"`
+	RemoteIovCount uint64 `protobuf:"varint,25,opt,name=remote_iov_count,json=remoteIovCount" json:"remote_iov_count,omitempty"`
+}
+
+func (m *MemoryEvent) Reset()                    { *m = MemoryEvent{} }
+func (m *MemoryEvent) String() string            { return proto.CompactTextString(m) }
+func (*MemoryEvent) ProtoMessage()               {}
+func (*MemoryEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13} }
+
+func (m *MemoryEvent) GetType() MemoryEventType {
+	if m != nil {
+		return m.Type
+	}
+	return MemoryEventType_MEMORY_EVENT_TYPE_UNKNOWN
+}
+
+func (m *MemoryEvent) GetAddress() uint64 {
+	if m != nil {
+		return m.Address
+	}
+	return 0
+}
+
+func (m *MemoryEvent) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *MemoryEvent) GetProt() int32 {
+	if m != nil {
+		return m.Prot
+	}
+	return 0
+}
+
+func (m *MemoryEvent) GetTargetPid() int32 {
+	if m != nil {
+		return m.TargetPid
+	}
+	return 0
+}
+
+func (m *MemoryEvent) GetTargetProcessId() string {
+	if m != nil {
+		return m.TargetProcessId
+	}
+	return ""
+}
+
+func (m *MemoryEvent) GetPtraceRequest() int64 {
+	if m != nil {
+		return m.PtraceRequest
+	}
+	return 0
+}
+
+func (m *MemoryEvent) GetPtraceAddress() uint64 {
+	if m != nil {
+		return m.PtraceAddress
+	}
+	return 0
+}
+
+func (m *MemoryEvent) GetRemoteAddress() uint64 {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return 0
+}
+
+func (m *MemoryEvent) GetRemoteIovCount() uint64 {
+	if m != nil {
+		return m.RemoteIovCount
+	}
+	return 0
+}
+
+// AlertEvent describes a pattern of activity detected by one of the Sensor's
+// built-in detection rules.
+type AlertEvent struct {
+	// The name of the rule that generated the alert
+	Rule string `protobuf:"bytes,1,opt,name=rule" json:"rule,omitempty"`
+	// A human-readable description of what was detected
+	Description string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	// The ids of the events that triggered the alert, in the order
+	// that they were observed
+	EventIds []string `protobuf:"bytes,3,rep,name=event_ids,json=eventIds" json:"event_ids,omitempty"`
+}
+
+func (m *AlertEvent) Reset()                    { *m = AlertEvent{} }
+func (m *AlertEvent) String() string            { return proto.CompactTextString(m) }
+func (*AlertEvent) ProtoMessage()               {}
+func (*AlertEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14} }
+
+func (m *AlertEvent) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *AlertEvent) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AlertEvent) GetEventIds() []string {
+	if m != nil {
+		return m.EventIds
+	}
+	return nil
+}
+
+// SensorMetricsEvent reports the Sensor's own resource usage.
+type SensorMetricsEvent struct {
+	// CPU time used by the Sensor process since the previous
+	// measurement, as a percentage of a single CPU
+	CpuPercent float64 `protobuf:"fixed64,1,opt,name=cpu_percent,json=cpuPercent" json:"cpu_percent,omitempty"`
+	// Resident memory used by the Sensor process (in bytes)
+	RssBytes uint64 `protobuf:"varint,2,opt,name=rss_bytes,json=rssBytes" json:"rss_bytes,omitempty"`
+	// Events created per second since the previous measurement
+	EventsPerSecond float64 `protobuf:"fixed64,3,opt,name=events_per_second,json=eventsPerSecond" json:"events_per_second,omitempty"`
+	// Number of subscriptions made since the Sensor started
+	Subscriptions int32 `protobuf:"varint,4,opt,name=subscriptions" json:"subscriptions,omitempty"`
+	// True if the Sensor is over its configured CPU or memory budget
+	// and is shedding events from low-priority sources
+	OverBudget bool `protobuf:"varint,5,opt,name=over_budget,json=overBudget" json:"over_budget,omitempty"`
+	// Number of kernel events currently disabled because the Sensor is
+	// over budget
+	ShedKernelEvents uint32 `protobuf:"varint,6,opt,name=shed_kernel_events,json=shedKernelEvents" json:"shed_kernel_events,omitempty"`
+	// Total number of events lost since the Sensor started because
+	// its perf ring buffers overflowed
+	LostEvents uint64 `protobuf:"varint,7,opt,name=lost_events,json=lostEvents" json:"lost_events,omitempty"`
+	// Lost events broken down by the event source of the kernel event
+	// that reported the loss and by CPU
+	LostEventsBySource map[string]uint64 `protobuf:"bytes,8,rep,name=lost_events_by_source,json=lostEventsBySource" json:"lost_events_by_source,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	LostEventsByCpu    map[int32]uint64  `protobuf:"bytes,9,rep,name=lost_events_by_cpu,json=lostEventsByCpu" json:"lost_events_by_cpu,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+}
+
+func (m *SensorMetricsEvent) Reset()                    { *m = SensorMetricsEvent{} }
+func (m *SensorMetricsEvent) String() string            { return proto.CompactTextString(m) }
+func (*SensorMetricsEvent) ProtoMessage()               {}
+func (*SensorMetricsEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{15} }
+
+func (m *SensorMetricsEvent) GetCpuPercent() float64 {
+	if m != nil {
+		return m.CpuPercent
+	}
+	return 0
+}
+
+func (m *SensorMetricsEvent) GetRssBytes() uint64 {
+	if m != nil {
+		return m.RssBytes
+	}
+	return 0
+}
+
+func (m *SensorMetricsEvent) GetEventsPerSecond() float64 {
+	if m != nil {
+		return m.EventsPerSecond
+	}
+	return 0
+}
+
+func (m *SensorMetricsEvent) GetSubscriptions() int32 {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return 0
+}
+
+func (m *SensorMetricsEvent) GetOverBudget() bool {
+	if m != nil {
+		return m.OverBudget
+	}
+	return false
+}
+
+func (m *SensorMetricsEvent) GetShedKernelEvents() uint32 {
+	if m != nil {
+		return m.ShedKernelEvents
+	}
+	return 0
+}
+
+func (m *SensorMetricsEvent) GetLostEvents() uint64 {
+	if m != nil {
+		return m.LostEvents
+	}
+	return 0
+}
+
+func (m *SensorMetricsEvent) GetLostEventsBySource() map[string]uint64 {
+	if m != nil {
+		return m.LostEventsBySource
+	}
+	return nil
+}
+
+func (m *SensorMetricsEvent) GetLostEventsByCpu() map[int32]uint64 {
+	if m != nil {
+		return m.LostEventsByCpu
+	}
+	return nil
+}
+
+// LostEventsEvent reports that the kernel dropped events because the Sensor
+// did not read them from a perf ring buffer quickly enough, so the events
+// received for the subscription are incomplete. The Event's cpu field
+// identifies the ring buffer that overflowed.
+type LostEventsEvent struct {
+	// The number of events lost
+	Count uint64 `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
+	// The event source (i.e. "syscall") of the kernel event that
+	// reported the loss. Ring buffers are shared by all event sources,
+	// so the lost events may have come from any of them.
+	Source string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty"`
+}
+
+func (m *LostEventsEvent) Reset()                    { *m = LostEventsEvent{} }
+func (m *LostEventsEvent) String() string            { return proto.CompactTextString(m) }
+func (*LostEventsEvent) ProtoMessage()               {}
+func (*LostEventsEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{16} }
+
+func (m *LostEventsEvent) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *LostEventsEvent) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Event)(nil), "capsule8.api.v0.Event")
 	proto.RegisterType((*ChargenEvent)(nil), "capsule8.api.v0.ChargenEvent")
@@ -1428,129 +2424,221 @@ func init() {
 	proto.RegisterType((*ProcessEvent)(nil), "capsule8.api.v0.ProcessEvent")
 	proto.RegisterType((*SyscallEvent)(nil), "capsule8.api.v0.SyscallEvent")
 	proto.RegisterType((*FileEvent)(nil), "capsule8.api.v0.FileEvent")
+	proto.RegisterType((*KubernetesMetadata)(nil), "capsule8.api.v0.KubernetesMetadata")
 	proto.RegisterType((*Process)(nil), "capsule8.api.v0.Process")
 	proto.RegisterType((*KernelFunctionCallEvent)(nil), "capsule8.api.v0.KernelFunctionCallEvent")
 	proto.RegisterType((*KernelFunctionCallEvent_FieldValue)(nil), "capsule8.api.v0.KernelFunctionCallEvent.FieldValue")
 	proto.RegisterType((*NetworkEvent)(nil), "capsule8.api.v0.NetworkEvent")
+	proto.RegisterType((*KernelLoadEvent)(nil), "capsule8.api.v0.KernelLoadEvent")
+	proto.RegisterType((*PrivilegeEvent)(nil), "capsule8.api.v0.PrivilegeEvent")
+	proto.RegisterType((*MemoryEvent)(nil), "capsule8.api.v0.MemoryEvent")
+	proto.RegisterType((*AlertEvent)(nil), "capsule8.api.v0.AlertEvent")
+	proto.RegisterType((*SensorMetricsEvent)(nil), "capsule8.api.v0.SensorMetricsEvent")
+	proto.RegisterType((*LostEventsEvent)(nil), "capsule8.api.v0.LostEventsEvent")
 	proto.RegisterEnum("capsule8.api.v0.ContainerEventType", ContainerEventType_name, ContainerEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.ProcessEventType", ProcessEventType_name, ProcessEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.SyscallEventType", SyscallEventType_name, SyscallEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.FileEventType", FileEventType_name, FileEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.KernelFunctionCallEventType", KernelFunctionCallEventType_name, KernelFunctionCallEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.NetworkEventType", NetworkEventType_name, NetworkEventType_value)
+	proto.RegisterEnum("capsule8.api.v0.KernelLoadEventType", KernelLoadEventType_name, KernelLoadEventType_value)
+	proto.RegisterEnum("capsule8.api.v0.PrivilegeEventType", PrivilegeEventType_name, PrivilegeEventType_value)
+	proto.RegisterEnum("capsule8.api.v0.MemoryEventType", MemoryEventType_name, MemoryEventType_value)
 	proto.RegisterEnum("capsule8.api.v0.KernelFunctionCallEvent_FieldType", KernelFunctionCallEvent_FieldType_name, KernelFunctionCallEvent_FieldType_value)
 }
 
 func init() { proto.RegisterFile("capsule8/api/v0/event.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 1715 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x31, 0x77, 0xdb, 0xc8,
-	0x11, 0x36, 0x48, 0x4a, 0x24, 0x87, 0x14, 0x0d, 0x6d, 0x74, 0x77, 0x38, 0xc9, 0x27, 0x51, 0x94,
-	0x7d, 0x66, 0x94, 0x3c, 0xd9, 0xa6, 0x64, 0x9f, 0x93, 0x26, 0x4f, 0x86, 0xc0, 0x98, 0x91, 0x0c,
-	0x2a, 0x4b, 0xc8, 0x77, 0x4e, 0x83, 0x07, 0x01, 0x2b, 0x1a, 0x11, 0x09, 0xf0, 0x00, 0xd0, 0xb1,
-	0xda, 0x74, 0x29, 0x52, 0xa4, 0x4a, 0x99, 0x2a, 0xbf, 0x23, 0x75, 0xf2, 0x27, 0x52, 0xa7, 0x4f,
-	0x9d, 0x97, 0xb7, 0xb3, 0x0b, 0x90, 0x92, 0x08, 0xeb, 0xd2, 0xa5, 0xdb, 0xfd, 0xbe, 0x6f, 0x66,
-	0x77, 0x67, 0x30, 0xb3, 0xfb, 0x00, 0x1b, 0xae, 0x33, 0x89, 0xa7, 0x23, 0xf6, 0xf2, 0x89, 0x33,
-	0xf1, 0x9f, 0x7c, 0x78, 0xfa, 0x84, 0x7d, 0x60, 0x41, 0xb2, 0x37, 0x89, 0xc2, 0x24, 0x24, 0xf7,
-	0x53, 0x72, 0xcf, 0x99, 0xf8, 0x7b, 0x1f, 0x9e, 0xae, 0xdf, 0x52, 0x27, 0x57, 0x13, 0x16, 0x0b,
-	0x75, 0xeb, 0xaf, 0x65, 0x58, 0x32, 0xb8, 0x35, 0x69, 0x40, 0xc1, 0xf7, 0x34, 0xa5, 0xa9, 0xb4,
-	0xab, 0xb4, 0xe0, 0x7b, 0xe4, 0x2b, 0x80, 0x49, 0x14, 0xba, 0x2c, 0x8e, 0x6d, 0xdf, 0xd3, 0x0a,
-	0x88, 0x57, 0x25, 0xd2, 0xf3, 0xc8, 0x16, 0xd4, 0x52, 0x7a, 0xe2, 0x7b, 0x5a, 0xb1, 0xa9, 0xb4,
-	0x97, 0x68, 0x6a, 0x71, 0xea, 0x7b, 0x64, 0x1b, 0xea, 0x6e, 0x18, 0x24, 0x8e, 0x1f, 0xb0, 0x88,
-	0x7b, 0x28, 0xa1, 0x87, 0x5a, 0x86, 0xf5, 0x3c, 0xb2, 0x01, 0xd5, 0x98, 0x05, 0x71, 0x88, 0xfc,
-	0x12, 0xf2, 0x15, 0x01, 0xf4, 0x3c, 0x72, 0x00, 0x9f, 0x4b, 0x32, 0x66, 0xdf, 0x4f, 0x59, 0xe0,
-	0x32, 0x3b, 0x98, 0x8e, 0xcf, 0x59, 0xa4, 0x2d, 0x37, 0x95, 0x76, 0x89, 0xae, 0x09, 0x76, 0x20,
-	0x49, 0x13, 0x39, 0xd2, 0x81, 0xcf, 0xa4, 0xd5, 0x38, 0x0c, 0xc2, 0xc4, 0x1f, 0x33, 0x3b, 0x70,
-	0x82, 0x30, 0xd6, 0xca, 0x4d, 0xa5, 0x5d, 0xa4, 0x3f, 0x12, 0xe4, 0x1b, 0xc9, 0x99, 0x9c, 0x22,
-	0x87, 0x70, 0x3f, 0x3d, 0xca, 0xc8, 0x0f, 0x98, 0x33, 0x64, 0x5a, 0xa5, 0x59, 0x6c, 0xd7, 0x3a,
-	0xda, 0xde, 0x8d, 0x58, 0xee, 0x9d, 0x0a, 0x1d, 0x6d, 0x48, 0x83, 0x13, 0xa1, 0x27, 0x8f, 0xa0,
-	0x31, 0x3b, 0x6c, 0xe0, 0x8c, 0x99, 0xb6, 0x89, 0xc7, 0x59, 0xc9, 0x50, 0xd3, 0x19, 0x33, 0xf2,
-	0x25, 0x54, 0xfc, 0xb1, 0x33, 0x64, 0xfc, 0xbc, 0x5b, 0x28, 0x28, 0xe3, 0xbc, 0x87, 0xe1, 0x16,
-	0x14, 0x5a, 0x37, 0x45, 0xb8, 0x11, 0x41, 0xcb, 0x9f, 0x41, 0x39, 0xbe, 0x8a, 0x5d, 0x67, 0x34,
-	0xd2, 0xa0, 0xa9, 0xb4, 0x6b, 0x9d, 0xaf, 0x6e, 0xed, 0x6d, 0x20, 0x78, 0xcc, 0xe6, 0xeb, 0x7b,
-	0x34, 0xd5, 0x73, 0x53, 0xb9, 0x5b, 0xad, 0x96, 0x63, 0x2a, 0x8f, 0x95, 0x99, 0x4a, 0x3d, 0x79,
-	0x0a, 0xa5, 0x0b, 0x7f, 0xc4, 0xb4, 0x3a, 0xda, 0xad, 0xdf, 0xb2, 0xeb, 0xfa, 0x23, 0x96, 0x1a,
-	0xa1, 0x92, 0x1c, 0x43, 0xed, 0x92, 0x45, 0x01, 0x1b, 0xd9, 0xb8, 0xd7, 0x15, 0x34, 0x6c, 0xdf,
-	0x32, 0x3c, 0x46, 0x4d, 0x77, 0x1a, 0xb8, 0x89, 0x1f, 0x06, 0xfa, 0xdc, 0xb6, 0x41, 0x98, 0xeb,
-	0x72, 0xe7, 0x01, 0x4b, 0x7e, 0x17, 0x46, 0x97, 0x5a, 0x23, 0x67, 0xe7, 0xa6, 0xe0, 0xb3, 0x9d,
-	0x4b, 0x3d, 0xf9, 0x05, 0x54, 0xb3, 0xd0, 0x6b, 0x6b, 0x68, 0xbc, 0x75, 0xcb, 0x58, 0x4f, 0x15,
-	0xa9, 0xf9, 0xcc, 0x86, 0xaf, 0xed, 0xbe, 0x77, 0xa2, 0x21, 0x0b, 0x34, 0x2f, 0x67, 0x6d, 0x5d,
-	0xf0, 0xd9, 0xda, 0x52, 0x4f, 0x5e, 0xc0, 0x72, 0xe2, 0xbb, 0x97, 0x2c, 0xd2, 0x18, 0x5a, 0x3e,
-	0xb8, 0x65, 0x69, 0x21, 0x9d, 0x1a, 0x4a, 0x35, 0x59, 0x85, 0xa2, 0x3b, 0x99, 0x6a, 0x7f, 0x57,
-	0xb0, 0x96, 0xf8, 0xf8, 0x55, 0x19, 0x96, 0xb0, 0xb6, 0x5b, 0x47, 0x50, 0x9f, 0x5f, 0x8e, 0xac,
-	0xc1, 0x92, 0x1f, 0x78, 0xec, 0x23, 0x16, 0x6c, 0x89, 0x8a, 0x09, 0xd9, 0x04, 0xe0, 0x9b, 0x70,
-	0xdc, 0x84, 0x45, 0xb1, 0xac, 0xd9, 0x39, 0xa4, 0xd5, 0x83, 0xda, 0xdc, 0xd2, 0x44, 0x83, 0x72,
-	0xcc, 0xdc, 0x30, 0xf0, 0x62, 0x74, 0x53, 0xa4, 0xe9, 0x94, 0x34, 0xa1, 0x86, 0x65, 0x23, 0xd9,
-	0x02, 0xb2, 0xf3, 0x50, 0xeb, 0x4f, 0x45, 0x68, 0x5c, 0x8f, 0x1f, 0xf9, 0x06, 0x4a, 0xbc, 0xb5,
-	0xa0, 0xaf, 0x46, 0x67, 0xe7, 0x8e, 0x70, 0x5b, 0x57, 0x13, 0x46, 0xd1, 0x80, 0x10, 0x28, 0xe1,
-	0x57, 0x2f, 0x36, 0x8c, 0xe3, 0x6b, 0xa5, 0x02, 0x9f, 0x2a, 0x95, 0xda, 0xcd, 0x52, 0xf9, 0x12,
-	0x2a, 0xef, 0xc3, 0x38, 0xc1, 0xb6, 0xc4, 0x33, 0xbf, 0x4a, 0xcb, 0x7c, 0xce, 0x7b, 0xd2, 0x06,
-	0x54, 0xd9, 0x47, 0x3f, 0xb1, 0xdd, 0xd0, 0x13, 0x15, 0xba, 0x4a, 0x2b, 0x1c, 0xd0, 0x43, 0x8f,
-	0xf1, 0x8e, 0x86, 0x64, 0x9c, 0x38, 0xc9, 0x34, 0xc6, 0xfa, 0x5c, 0xa1, 0xc0, 0xa1, 0x01, 0x22,
-	0x33, 0x81, 0x3f, 0x0c, 0x9c, 0x11, 0xd6, 0x68, 0x2a, 0x40, 0x84, 0xb4, 0x41, 0x95, 0xee, 0x23,
-	0x66, 0x7b, 0xd3, 0xf1, 0x84, 0x79, 0xda, 0x76, 0x53, 0x69, 0x57, 0x68, 0x43, 0xac, 0x12, 0xb1,
-	0x23, 0x44, 0xc9, 0x4f, 0x81, 0x78, 0x21, 0x4f, 0x84, 0xed, 0x86, 0xc1, 0x85, 0x3f, 0xb4, 0x7f,
-	0x1b, 0x87, 0xe2, 0x43, 0xab, 0x52, 0x55, 0x30, 0x3a, 0x12, 0xbf, 0x8a, 0xc3, 0x80, 0x7c, 0x0d,
-	0xf7, 0x43, 0xd7, 0xbf, 0x26, 0x65, 0xa2, 0xbd, 0x84, 0xae, 0x3f, 0xd3, 0xb5, 0xfe, 0x55, 0x80,
-	0xfa, 0x7c, 0x29, 0x93, 0xe7, 0xd7, 0x32, 0xb2, 0xfd, 0xc9, 0xba, 0x9f, 0xcb, 0xc7, 0x43, 0x68,
-	0x5c, 0x84, 0xd1, 0xa5, 0xed, 0xbe, 0xf7, 0x47, 0x1e, 0xc6, 0x11, 0x30, 0x56, 0x75, 0x8e, 0xea,
-	0x1c, 0xe4, 0xc1, 0x6c, 0xc1, 0xca, 0x9c, 0xca, 0xf7, 0x64, 0x26, 0x6a, 0x99, 0xa8, 0xe7, 0x91,
-	0x1d, 0x58, 0x61, 0x1f, 0x99, 0x6b, 0xf3, 0xde, 0x80, 0xd9, 0x5a, 0x43, 0x4d, 0x9d, 0x83, 0x5d,
-	0x89, 0x91, 0x5d, 0x58, 0x45, 0x91, 0x1b, 0x8e, 0xc7, 0x4e, 0xe0, 0x61, 0x13, 0xd6, 0x3e, 0x6b,
-	0x16, 0xdb, 0x55, 0x7a, 0x9f, 0x13, 0xba, 0xc0, 0x79, 0xaf, 0xfd, 0xbf, 0xc9, 0x60, 0xeb, 0x9f,
-	0x0a, 0xd4, 0xe7, 0x3b, 0xee, 0x9d, 0xb1, 0x9e, 0x17, 0xcf, 0xc5, 0x5a, 0x5c, 0xbb, 0xa2, 0xc0,
-	0xf8, 0xb5, 0x4b, 0xa0, 0xe4, 0x44, 0xc3, 0xa7, 0x18, 0xf1, 0x12, 0xc5, 0xb1, 0xc4, 0x9e, 0x61,
-	0x80, 0x05, 0xf6, 0x4c, 0x62, 0x1d, 0x6c, 0xcd, 0x02, 0xeb, 0x48, 0x6c, 0x1f, 0xbb, 0xae, 0xc0,
-	0xf6, 0x25, 0x76, 0x80, 0x0d, 0x54, 0x60, 0x07, 0x12, 0x7b, 0xae, 0xdd, 0xcf, 0xb0, 0xe7, 0x44,
-	0x85, 0x62, 0xc4, 0x12, 0xcc, 0x4f, 0x91, 0xf2, 0x61, 0xeb, 0xcf, 0x0a, 0x54, 0xb3, 0x06, 0x4f,
-	0x3a, 0xd7, 0x8e, 0xb7, 0x99, 0x7f, 0x15, 0xcc, 0x9d, 0x6d, 0x1d, 0x2a, 0x59, 0xe2, 0x45, 0x0d,
-	0x67, 0x73, 0x5e, 0xc4, 0xe1, 0x84, 0x05, 0xf6, 0xc5, 0xc8, 0x19, 0x8a, 0x8b, 0x69, 0x95, 0x56,
-	0x39, 0xd2, 0xe5, 0x00, 0xcf, 0x33, 0xd2, 0x63, 0x9e, 0xe7, 0xba, 0xc8, 0x33, 0x07, 0xde, 0x84,
-	0x1e, 0x6b, 0x3d, 0x87, 0xb2, 0xfc, 0x72, 0xf9, 0xb6, 0x27, 0xf2, 0xd9, 0xb2, 0x4a, 0xf9, 0x90,
-	0x37, 0x35, 0xf9, 0x21, 0xc9, 0x7e, 0x92, 0x4e, 0x5b, 0xff, 0x2e, 0xc1, 0x17, 0x39, 0x17, 0x0f,
-	0x39, 0x83, 0xaa, 0x13, 0x0d, 0xa7, 0x63, 0x16, 0x24, 0xbc, 0x19, 0xf2, 0xdb, 0xff, 0x9b, 0x1f,
-	0x7a, 0x6b, 0xed, 0x1d, 0xa6, 0x96, 0x46, 0x90, 0x44, 0x57, 0x74, 0xe6, 0x69, 0xfd, 0x3f, 0x0a,
-	0x40, 0xd7, 0x67, 0x23, 0xef, 0xad, 0x33, 0x9a, 0x32, 0xf2, 0x6b, 0x80, 0x0b, 0x3e, 0xb3, 0xe7,
-	0x42, 0xd9, 0xf9, 0xc1, 0xcb, 0xa0, 0x23, 0x0c, 0x6f, 0xf5, 0x22, 0x1d, 0x92, 0x6d, 0xa8, 0x9d,
-	0x5f, 0x25, 0x2c, 0xb6, 0x3f, 0xf0, 0x15, 0xf0, 0xc8, 0x75, 0x7e, 0x8d, 0x22, 0x28, 0x56, 0xdd,
-	0x81, 0x7a, 0x9c, 0x44, 0x7e, 0x30, 0x94, 0x1a, 0xfe, 0x56, 0xab, 0xbe, 0xbe, 0x47, 0x6b, 0x02,
-	0x9d, 0x89, 0xfc, 0x61, 0xc0, 0x3c, 0x29, 0xe2, 0xcf, 0x35, 0x82, 0x22, 0x44, 0x85, 0xe8, 0x31,
-	0x34, 0xa6, 0xc1, 0x35, 0x19, 0x7f, 0xb5, 0x95, 0x5e, 0xdf, 0xa3, 0x2b, 0x29, 0x8e, 0x42, 0x7e,
-	0x6f, 0x21, 0xbf, 0xfe, 0x3d, 0x34, 0xae, 0x47, 0x87, 0x67, 0xec, 0x92, 0x5d, 0xc9, 0x87, 0x26,
-	0x1f, 0x92, 0x9e, 0x14, 0xe3, 0xe6, 0x6b, 0x9d, 0xfd, 0xff, 0x2d, 0x20, 0xb8, 0x20, 0x15, 0x1e,
-	0x7e, 0x5e, 0x78, 0xa9, 0xb4, 0xfe, 0x88, 0xdf, 0x6d, 0x1a, 0x9f, 0x1a, 0x94, 0xcf, 0xcc, 0x63,
-	0xb3, 0xff, 0xad, 0xa9, 0xde, 0x23, 0x55, 0x58, 0x7a, 0xf5, 0xce, 0x32, 0x06, 0xaa, 0x42, 0x00,
-	0x96, 0x07, 0x16, 0xed, 0x99, 0xbf, 0x54, 0x0b, 0x1c, 0x1e, 0xf4, 0x4c, 0xeb, 0xa5, 0x5a, 0x44,
-	0xb8, 0x67, 0x5a, 0xcf, 0x5e, 0xa8, 0xa5, 0x74, 0xbc, 0xdf, 0x51, 0x97, 0xd2, 0xf1, 0x8b, 0x03,
-	0x75, 0x99, 0xcb, 0xcf, 0x50, 0x5e, 0xe6, 0xf0, 0x99, 0x90, 0x57, 0xd2, 0xf1, 0x7e, 0x47, 0xad,
-	0xa6, 0xe3, 0x17, 0x07, 0x2a, 0xb4, 0xfe, 0xa1, 0x40, 0x7d, 0xfe, 0x99, 0x72, 0x67, 0xa7, 0x98,
-	0x17, 0xcf, 0x55, 0xd3, 0xe7, 0xb0, 0x1c, 0x87, 0xee, 0xe5, 0x85, 0x27, 0x7b, 0x83, 0x9c, 0xf1,
-	0x97, 0x8a, 0xe3, 0x79, 0xd1, 0xec, 0x7d, 0xb7, 0x95, 0xe7, 0xf1, 0x50, 0xc8, 0x68, 0xaa, 0xe7,
-	0x2e, 0x23, 0x16, 0x4f, 0x47, 0x09, 0x96, 0x18, 0xa1, 0x72, 0xc6, 0x6b, 0xe8, 0xdc, 0x71, 0x2f,
-	0x47, 0xe1, 0x50, 0xf6, 0x92, 0x74, 0xba, 0xfb, 0x37, 0x05, 0xc8, 0xed, 0x7b, 0x9c, 0x34, 0xe1,
-	0x81, 0xde, 0x37, 0xad, 0xc3, 0x9e, 0x69, 0x50, 0xdb, 0x78, 0x6b, 0x98, 0x96, 0x6d, 0xbd, 0x3b,
-	0x35, 0xec, 0x59, 0xe8, 0xf3, 0x14, 0x3a, 0x35, 0x0e, 0x2d, 0xe3, 0x48, 0x55, 0x72, 0x15, 0xf4,
-	0xcc, 0x34, 0x45, 0x9e, 0xb6, 0x60, 0x63, 0xa1, 0xc2, 0xf8, 0xae, 0xc7, 0x5d, 0x14, 0x49, 0x0b,
-	0x36, 0x17, 0x0a, 0x8e, 0x8c, 0x81, 0x45, 0xfb, 0xef, 0x8c, 0x23, 0xb5, 0xb4, 0xfb, 0x07, 0x05,
-	0xd4, 0x9b, 0xf7, 0x1e, 0xd9, 0x84, 0xf5, 0x53, 0xda, 0xd7, 0x8d, 0xc1, 0x60, 0xf1, 0xee, 0x37,
-	0xe0, 0x8b, 0x05, 0x7c, 0xb7, 0x4f, 0x8f, 0x55, 0x25, 0x87, 0x34, 0xbe, 0x33, 0x74, 0xb5, 0x90,
-	0x4b, 0xf6, 0x2c, 0xb5, 0xb8, 0x3b, 0x06, 0xf5, 0xe6, 0xb5, 0xc0, 0xb7, 0x32, 0x78, 0x37, 0xd0,
-	0x0f, 0x4f, 0x4e, 0x16, 0x6f, 0xe5, 0x01, 0x68, 0x0b, 0x78, 0xc3, 0xb4, 0x0c, 0x2a, 0xf6, 0xb2,
-	0x88, 0xe5, 0xcb, 0x15, 0x76, 0xbb, 0xb0, 0x72, 0xad, 0x4d, 0x73, 0x75, 0xb7, 0x77, 0x62, 0x2c,
-	0x5e, 0x48, 0x83, 0xb5, 0x9b, 0x64, 0xff, 0xd4, 0x30, 0x55, 0x65, 0xf7, 0x2f, 0x0a, 0x6c, 0xe4,
-	0xd4, 0x24, 0xba, 0xfd, 0x09, 0x3c, 0x3e, 0x36, 0xa8, 0x69, 0x9c, 0xd8, 0xdd, 0x33, 0x53, 0xb7,
-	0x7a, 0x7d, 0xd3, 0xce, 0x3f, 0xcf, 0x8f, 0xe1, 0xd1, 0x5d, 0xe2, 0xf4, 0x70, 0x6d, 0x78, 0x78,
-	0xa7, 0x54, 0x9c, 0xf4, 0xf7, 0x25, 0x50, 0x6f, 0x96, 0x11, 0x8f, 0xac, 0x69, 0x58, 0xdf, 0xf6,
-	0xe9, 0xf1, 0xe2, 0x9d, 0x7c, 0x0d, 0xad, 0x05, 0xbc, 0xde, 0x37, 0x4d, 0x43, 0xb7, 0xec, 0x43,
-	0xcb, 0x32, 0xde, 0x9c, 0x5a, 0xaa, 0x42, 0x1e, 0xc1, 0xf6, 0x27, 0x74, 0xd4, 0x18, 0x9c, 0x9d,
-	0x58, 0x6a, 0x81, 0xec, 0xc0, 0xd6, 0x02, 0xd9, 0xab, 0x9e, 0x79, 0x94, 0xf9, 0xc2, 0x2f, 0x36,
-	0x4f, 0x24, 0x1d, 0x95, 0x72, 0xd6, 0x3b, 0xe9, 0x0d, 0x2c, 0xc3, 0xcc, 0x5c, 0x2d, 0x91, 0x87,
-	0xd0, 0xcc, 0x97, 0x49, 0x67, 0xcb, 0x39, 0xce, 0x0e, 0x75, 0xdd, 0x38, 0x9d, 0x9d, 0xb1, 0x9c,
-	0xe3, 0x4c, 0xca, 0xa4, 0xb3, 0x4a, 0x8e, 0xb3, 0x81, 0x61, 0x1e, 0x59, 0xfd, 0xcc, 0x59, 0x35,
-	0xc7, 0x99, 0x94, 0x49, 0x67, 0x40, 0x1e, 0xc3, 0xce, 0x02, 0x15, 0x35, 0xf4, 0xb7, 0x5d, 0xda,
-	0x7f, 0x93, 0xb9, 0xab, 0xe5, 0xe4, 0x29, 0x13, 0x4a, 0x87, 0xf5, 0x57, 0x8f, 0x7e, 0xb3, 0x33,
-	0xf4, 0x93, 0xf7, 0xd3, 0xf3, 0x3d, 0x37, 0x1c, 0x3f, 0xc9, 0xfe, 0x82, 0xdc, 0xf8, 0x1d, 0x72,
-	0xbe, 0x8c, 0x7f, 0x42, 0xf6, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xd4, 0xcf, 0x92, 0x56,
-	0x11, 0x00, 0x00,
+	// 3038 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0xdc, 0x46,
+	0x96, 0x77, 0x7f, 0xa9, 0xd5, 0xaf, 0x3f, 0x44, 0x95, 0x3f, 0x44, 0x4b, 0xb1, 0xd5, 0x6e, 0xd9,
+	0xb1, 0xa2, 0x0d, 0x64, 0x47, 0x72, 0x1c, 0xef, 0x66, 0x81, 0x40, 0x6a, 0x51, 0x71, 0xaf, 0x25,
+	0x76, 0xa7, 0xba, 0xe5, 0xc4, 0x7b, 0x21, 0x28, 0xb2, 0xba, 0xc5, 0x15, 0x9b, 0x64, 0x48, 0xb6,
+	0x12, 0x01, 0xfb, 0x0f, 0xec, 0x61, 0x0f, 0x0b, 0x2c, 0xb0, 0xd8, 0xc1, 0x00, 0x73, 0x99, 0xf9,
+	0x3b, 0xe6, 0x3c, 0xff, 0x48, 0x80, 0xb9, 0x05, 0xc8, 0x60, 0x8e, 0x83, 0x41, 0x7d, 0x90, 0xcd,
+	0xee, 0x26, 0x2d, 0xe7, 0x36, 0x37, 0xd6, 0xef, 0xfd, 0xde, 0xab, 0xaa, 0xf7, 0xaa, 0x5e, 0xbd,
+	0x2a, 0xc2, 0x86, 0xa1, 0x7b, 0xc1, 0xc4, 0x26, 0xaf, 0x9e, 0xe9, 0x9e, 0xf5, 0xec, 0xea, 0xf9,
+	0x33, 0x72, 0x45, 0x9c, 0x70, 0xd7, 0xf3, 0xdd, 0xd0, 0x45, 0x2b, 0x91, 0x70, 0x57, 0xf7, 0xac,
+	0xdd, 0xab, 0xe7, 0xeb, 0x0b, 0xec, 0xf0, 0xda, 0x23, 0x01, 0x67, 0xb7, 0x7e, 0xae, 0x42, 0x49,
+	0xa1, 0xda, 0xa8, 0x01, 0x79, 0xcb, 0x94, 0x73, 0xcd, 0xdc, 0x76, 0x05, 0xe7, 0x2d, 0x13, 0x3d,
+	0x00, 0xf0, 0x7c, 0xd7, 0x20, 0x41, 0xa0, 0x59, 0xa6, 0x9c, 0x67, 0x78, 0x45, 0x20, 0x1d, 0x13,
+	0x6d, 0x42, 0x35, 0x12, 0x7b, 0x96, 0x29, 0x17, 0x9a, 0xb9, 0xed, 0x12, 0x8e, 0x34, 0x7a, 0x96,
+	0x89, 0x1e, 0x41, 0xcd, 0x70, 0x9d, 0x50, 0xb7, 0x1c, 0xe2, 0x53, 0x0b, 0x45, 0x66, 0xa1, 0x1a,
+	0x63, 0x1d, 0x13, 0x6d, 0x40, 0x25, 0x20, 0x4e, 0xe0, 0x32, 0x79, 0x89, 0xc9, 0x97, 0x39, 0xd0,
+	0x31, 0xd1, 0x0b, 0xb8, 0x27, 0x84, 0x01, 0xf9, 0x7e, 0x42, 0x1c, 0x83, 0x68, 0xce, 0x64, 0x7c,
+	0x4e, 0x7c, 0x79, 0xa9, 0x99, 0xdb, 0x2e, 0xe2, 0x3b, 0x5c, 0xda, 0x17, 0x42, 0x95, 0xc9, 0xd0,
+	0x1e, 0xdc, 0x15, 0x5a, 0x63, 0xd7, 0x71, 0x43, 0x6b, 0x4c, 0x34, 0x47, 0x77, 0xdc, 0x40, 0x2e,
+	0x37, 0x73, 0xdb, 0x05, 0x7c, 0x9b, 0x0b, 0x4f, 0x85, 0x4c, 0xa5, 0x22, 0x74, 0x00, 0x2b, 0xd1,
+	0x54, 0x6c, 0xcb, 0x21, 0xfa, 0x88, 0xc8, 0xcb, 0xcd, 0xc2, 0x76, 0x75, 0x4f, 0xde, 0x9d, 0xf3,
+	0xe5, 0x6e, 0x8f, 0xf3, 0x70, 0x43, 0x28, 0x9c, 0x70, 0x3e, 0x7a, 0x02, 0x8d, 0xc0, 0xb8, 0x20,
+	0x63, 0x5d, 0xbb, 0x22, 0x7e, 0x60, 0xb9, 0x8e, 0x5c, 0x69, 0xe6, 0xb6, 0xeb, 0xb8, 0xce, 0xd1,
+	0xb7, 0x1c, 0xa4, 0xb4, 0xa9, 0x4f, 0x1c, 0x7d, 0x4c, 0xe4, 0x87, 0x6c, 0xd6, 0xf5, 0x18, 0x55,
+	0xf5, 0x31, 0x41, 0xf7, 0x61, 0xd9, 0x1a, 0xeb, 0x23, 0x42, 0xdd, 0xb2, 0xc9, 0x08, 0x65, 0xd6,
+	0xee, 0xb0, 0xa8, 0x70, 0x11, 0xd3, 0x6e, 0xf2, 0xa8, 0x30, 0x84, 0x69, 0xb6, 0x01, 0x2e, 0x27,
+	0xe7, 0xc4, 0x77, 0x48, 0x48, 0x02, 0xf9, 0x51, 0x33, 0xb7, 0x5d, 0xdd, 0xdb, 0x5a, 0x98, 0xc5,
+	0x9b, 0x98, 0x72, 0x4a, 0x42, 0xdd, 0xd4, 0x43, 0x1d, 0x27, 0xd4, 0xd0, 0x53, 0x58, 0x31, 0x5c,
+	0xdd, 0x26, 0x81, 0x41, 0x4c, 0xcd, 0x70, 0x27, 0x4e, 0x28, 0xb7, 0x98, 0xcb, 0x1b, 0x31, 0xdc,
+	0xa6, 0x28, 0xfa, 0x12, 0xd6, 0x6d, 0x3d, 0x08, 0xb5, 0x74, 0x8f, 0x6f, 0x31, 0x8f, 0xaf, 0x51,
+	0x46, 0x3f, 0xc5, 0xeb, 0xff, 0x0c, 0xe5, 0xe0, 0x3a, 0x30, 0x74, 0xdb, 0x96, 0x81, 0x8d, 0xf3,
+	0xc1, 0xc2, 0x38, 0xfb, 0x5c, 0xce, 0xd6, 0xe7, 0xeb, 0x5b, 0x38, 0xe2, 0x53, 0x55, 0xe1, 0x7f,
+	0xb9, 0x9a, 0xa1, 0x2a, 0x02, 0x15, 0xab, 0x0a, 0x3e, 0x7a, 0x0e, 0xc5, 0xa1, 0x65, 0x13, 0xb9,
+	0xc6, 0xf4, 0xd6, 0x17, 0xf4, 0x8e, 0x2d, 0x9b, 0x44, 0x4a, 0x8c, 0x89, 0xde, 0x40, 0xf5, 0x92,
+	0x7a, 0xc6, 0xd6, 0xd8, 0x58, 0xeb, 0x4c, 0x71, 0x7b, 0xd1, 0xa7, 0x8c, 0x73, 0x3c, 0x71, 0x8c,
+	0xd0, 0x72, 0x9d, 0x76, 0x62, 0xd8, 0xc0, 0xd5, 0xdb, 0x62, 0xe4, 0x0e, 0x09, 0x7f, 0x70, 0xfd,
+	0x4b, 0xb9, 0x91, 0x31, 0x72, 0x95, 0xcb, 0xe3, 0x91, 0x0b, 0x3e, 0x6a, 0xc7, 0xe3, 0xb0, 0x5d,
+	0xdd, 0x94, 0x57, 0x98, 0x7a, 0x33, 0x63, 0x1c, 0x27, 0xae, 0x6e, 0xce, 0xf5, 0x4f, 0x21, 0xf4,
+	0x15, 0x54, 0x3c, 0xdf, 0xba, 0xb2, 0x6c, 0x32, 0x22, 0xb2, 0xc4, 0x4c, 0x6c, 0xa6, 0xf8, 0x4e,
+	0x30, 0x22, 0x0b, 0x53, 0x1d, 0xf4, 0x12, 0x96, 0xc6, 0x64, 0xec, 0xfa, 0xd7, 0xf2, 0x2a, 0xd3,
+	0xfe, 0x68, 0x41, 0xfb, 0x94, 0x89, 0x23, 0x55, 0xc1, 0xa6, 0x1d, 0xc7, 0x6b, 0x5c, 0xbe, 0x93,
+	0xd1, 0x71, 0x3b, 0x62, 0xc4, 0x1d, 0xc7, 0x3a, 0x68, 0x1f, 0x4a, 0xba, 0x4d, 0xfc, 0x50, 0xde,
+	0x63, 0xca, 0x1b, 0x0b, 0xca, 0x07, 0x54, 0x1a, 0x29, 0x72, 0x2e, 0xfa, 0x0a, 0xca, 0x63, 0x12,
+	0xfa, 0x96, 0x11, 0xc8, 0xfb, 0x19, 0x7b, 0x41, 0x2c, 0x4d, 0xce, 0x8a, 0x9d, 0x2e, 0xb4, 0xa8,
+	0xd3, 0x6d, 0x37, 0x08, 0x35, 0x96, 0x60, 0x03, 0xf9, 0x45, 0x86, 0xd3, 0x4f, 0xdc, 0x80, 0x77,
+	0x1d, 0x5b, 0x00, 0x3b, 0x86, 0x68, 0xd0, 0x8d, 0x0b, 0xdd, 0x1f, 0x11, 0x47, 0x36, 0x33, 0x82,
+	0xde, 0xe6, 0xf2, 0xb8, 0x7f, 0xc1, 0xa7, 0xee, 0x0e, 0x2d, 0xe3, 0x92, 0xf8, 0x32, 0xc9, 0x70,
+	0xf7, 0x80, 0x89, 0x63, 0x77, 0x73, 0x36, 0x5a, 0x85, 0x82, 0xe1, 0x4d, 0xe4, 0x3f, 0xe5, 0x58,
+	0x5a, 0xa6, 0xdf, 0x87, 0x65, 0x28, 0xb1, 0x59, 0xb4, 0x8e, 0xa0, 0x96, 0xec, 0x0e, 0xdd, 0x81,
+	0x92, 0xe5, 0x98, 0xe4, 0x47, 0x96, 0xfb, 0x8b, 0x98, 0x37, 0xd0, 0x43, 0x00, 0x3a, 0x08, 0xdd,
+	0x08, 0x89, 0x1f, 0x88, 0xf4, 0x9f, 0x40, 0x5a, 0x1d, 0xa8, 0x26, 0xba, 0x46, 0x32, 0x94, 0x03,
+	0x62, 0xb8, 0x8e, 0x19, 0x30, 0x33, 0x05, 0x1c, 0x35, 0x51, 0x13, 0xaa, 0x2c, 0x1f, 0x08, 0x69,
+	0x9e, 0x49, 0x93, 0x50, 0xeb, 0x7f, 0x0a, 0xd0, 0x98, 0x0d, 0x3d, 0xfa, 0x02, 0x8a, 0xf4, 0x94,
+	0x62, 0xb6, 0x1a, 0x29, 0x51, 0x9b, 0xa5, 0x0f, 0xae, 0x3d, 0x82, 0x99, 0x02, 0x42, 0x50, 0x64,
+	0x99, 0x91, 0x0f, 0xb8, 0xe8, 0xcc, 0xa7, 0x53, 0x78, 0x5f, 0x3a, 0xad, 0xce, 0xa7, 0xd3, 0xfb,
+	0xb0, 0x7c, 0x41, 0xc3, 0x4f, 0x4f, 0x38, 0xba, 0x68, 0x57, 0x71, 0x99, 0xb6, 0xe9, 0xf1, 0xb6,
+	0x01, 0x15, 0xf2, 0xa3, 0x15, 0x6a, 0x86, 0x6b, 0xf2, 0x2c, 0xbe, 0x8a, 0x97, 0x29, 0xd0, 0x76,
+	0x4d, 0x42, 0x0f, 0x47, 0x26, 0x0c, 0x42, 0x3d, 0x9c, 0x04, 0x2c, 0x87, 0xd7, 0x31, 0x50, 0xa8,
+	0xcf, 0x90, 0x29, 0xc1, 0x1a, 0x39, 0xba, 0x2d, 0x37, 0x13, 0x04, 0x86, 0xa0, 0x6d, 0x90, 0x84,
+	0x79, 0x9f, 0x68, 0xe6, 0x64, 0xec, 0x11, 0x93, 0xa5, 0xf3, 0x65, 0xdc, 0xe0, 0xbd, 0xf8, 0xe4,
+	0x88, 0xa1, 0xe8, 0x53, 0x40, 0xa6, 0x4b, 0x03, 0xa1, 0x19, 0xae, 0x33, 0xb4, 0x46, 0xda, 0x7f,
+	0x04, 0x2e, 0x5f, 0x68, 0x15, 0x2c, 0x71, 0x49, 0x9b, 0x09, 0xfe, 0x2d, 0x70, 0x1d, 0xf4, 0x31,
+	0xac, 0xb8, 0x86, 0x35, 0x43, 0x25, 0xfc, 0x08, 0x72, 0x0d, 0x6b, 0xca, 0x6b, 0xfd, 0x39, 0x0f,
+	0xb5, 0x64, 0x0e, 0x45, 0x9f, 0xcf, 0x44, 0xe4, 0xd1, 0x7b, 0x13, 0x6e, 0x22, 0x1e, 0x8f, 0xa1,
+	0x31, 0x74, 0xfd, 0x4b, 0xcd, 0xb8, 0xb0, 0x6c, 0x53, 0xf3, 0x44, 0x04, 0x56, 0x71, 0x8d, 0xa2,
+	0x6d, 0x0a, 0x52, 0x67, 0xb6, 0xa0, 0x9e, 0x60, 0x59, 0xa6, 0x88, 0x44, 0x35, 0x26, 0x75, 0x4c,
+	0xb4, 0x05, 0x75, 0xf2, 0x23, 0x31, 0x34, 0x9a, 0x94, 0x59, 0xb4, 0xee, 0x30, 0x4e, 0x8d, 0x82,
+	0xc7, 0x02, 0x43, 0x3b, 0xb0, 0xca, 0x48, 0x86, 0x3b, 0x1e, 0xeb, 0x8e, 0xc9, 0xce, 0x73, 0xf9,
+	0x6e, 0xb3, 0xb0, 0x5d, 0xc1, 0x2b, 0x54, 0xd0, 0xe6, 0x38, 0x3d, 0xb6, 0xff, 0x61, 0x22, 0xd8,
+	0xfa, 0x25, 0x07, 0xb5, 0xe4, 0x51, 0x77, 0xa3, 0xaf, 0x93, 0xe4, 0x84, 0xaf, 0x79, 0x05, 0xc7,
+	0x37, 0x18, 0xad, 0xe0, 0xa2, 0xbd, 0x50, 0x48, 0xec, 0x05, 0x04, 0x45, 0xdd, 0x1f, 0x3d, 0x67,
+	0x51, 0x28, 0x62, 0xf6, 0x2d, 0xb0, 0xcf, 0xe4, 0x6a, 0x8c, 0x7d, 0x26, 0xb0, 0x3d, 0xb9, 0x16,
+	0x63, 0x7b, 0x02, 0xdb, 0x97, 0xeb, 0x31, 0xb6, 0x2f, 0xb0, 0x17, 0x72, 0x23, 0xc6, 0x5e, 0x08,
+	0xec, 0x73, 0x79, 0x25, 0xc6, 0x3e, 0x47, 0x12, 0x14, 0x7c, 0x12, 0xb2, 0x98, 0x15, 0x30, 0xfd,
+	0x6c, 0xfd, 0x94, 0x83, 0x4a, 0x7c, 0xda, 0xa2, 0xbd, 0x99, 0x29, 0x3f, 0xcc, 0x3e, 0x97, 0x13,
+	0xf3, 0x5d, 0x87, 0xe5, 0x78, 0x31, 0xf0, 0x7d, 0x1d, 0xb7, 0xe9, 0xc6, 0x76, 0x3d, 0xe2, 0x68,
+	0x43, 0x5b, 0x1f, 0xf1, 0x2a, 0x61, 0x15, 0x57, 0x28, 0x72, 0x4c, 0x01, 0x1a, 0x7b, 0x26, 0x1e,
+	0xd3, 0xd8, 0xd7, 0x78, 0xec, 0x29, 0x70, 0x4a, 0x63, 0xbf, 0x0b, 0xb7, 0x7d, 0x66, 0x45, 0x73,
+	0xc8, 0x0f, 0xf3, 0xeb, 0x6d, 0x95, 0x8b, 0x54, 0xf2, 0xc3, 0x71, 0xa2, 0x2f, 0xe3, 0x62, 0xec,
+	0x9a, 0xda, 0x78, 0xba, 0x92, 0x2a, 0x0c, 0xa1, 0xe6, 0x5a, 0x7f, 0xc8, 0x03, 0x5a, 0xac, 0xb8,
+	0x68, 0x6e, 0xf1, 0x5c, 0x93, 0x27, 0x1e, 0x5e, 0x75, 0x97, 0x3d, 0xd7, 0x64, 0x69, 0x67, 0x0b,
+	0xea, 0x91, 0x28, 0xf0, 0x74, 0x23, 0xca, 0x66, 0x35, 0x21, 0x67, 0x18, 0x5a, 0x03, 0xca, 0xd7,
+	0x26, 0xa2, 0xf8, 0xae, 0xe0, 0x25, 0xcf, 0x35, 0xcf, 0x2c, 0x33, 0xa5, 0xc8, 0x2c, 0xa6, 0x15,
+	0x99, 0xdf, 0x00, 0x50, 0x7d, 0x5b, 0x3f, 0x27, 0x76, 0x20, 0x97, 0x58, 0xc1, 0xbb, 0xf7, 0x01,
+	0xa5, 0xe2, 0x6e, 0xcf, 0x35, 0x4f, 0x98, 0x92, 0xe2, 0x84, 0xfe, 0x35, 0xae, 0x78, 0x51, 0x7b,
+	0xfd, 0x5f, 0xa1, 0x31, 0x2b, 0xa4, 0x61, 0xbf, 0x24, 0xd7, 0x62, 0x7e, 0xf4, 0x93, 0x9e, 0x36,
+	0x57, 0xba, 0x3d, 0x89, 0xe6, 0xc4, 0x1b, 0xff, 0x92, 0x7f, 0x95, 0x6b, 0xfd, 0x26, 0x07, 0x65,
+	0x91, 0x45, 0xa8, 0x9e, 0x27, 0x6e, 0x23, 0xab, 0x98, 0x7e, 0xd2, 0x03, 0x46, 0x6c, 0x6a, 0xa1,
+	0x19, 0x35, 0xe7, 0x2e, 0x2a, 0x85, 0xf9, 0x8b, 0x0a, 0xbb, 0x87, 0x24, 0xb2, 0x41, 0x91, 0x65,
+	0x83, 0xaa, 0x91, 0xc8, 0x04, 0xf3, 0x57, 0x95, 0xd2, 0xc2, 0x55, 0xa5, 0xf5, 0x97, 0x22, 0xac,
+	0x65, 0x94, 0x78, 0xe8, 0x0c, 0x2a, 0xba, 0x3f, 0x9a, 0x8c, 0x59, 0x89, 0x90, 0x63, 0x8e, 0xfc,
+	0xe2, 0x43, 0xeb, 0xc3, 0xdd, 0x83, 0x48, 0x53, 0x78, 0x33, 0xb6, 0xb4, 0xfe, 0xb7, 0x1c, 0xc0,
+	0xb1, 0x45, 0x6c, 0xf3, 0x2d, 0x75, 0x11, 0x8d, 0xd7, 0x90, 0xb6, 0xb4, 0xc4, 0x3e, 0xd9, 0xfb,
+	0xe0, 0x6e, 0x98, 0x21, 0xb6, 0x77, 0x2a, 0xc3, 0xe8, 0x13, 0x3d, 0x82, 0xea, 0xf9, 0x75, 0x48,
+	0x02, 0x6d, 0x1a, 0x91, 0x1a, 0xad, 0x5d, 0x18, 0xc8, 0x7b, 0xdd, 0x82, 0x5a, 0x10, 0xfa, 0x96,
+	0x33, 0x12, 0x1c, 0xe6, 0xde, 0xd7, 0xb7, 0x70, 0x95, 0xa3, 0x53, 0x92, 0x35, 0x72, 0x88, 0x29,
+	0x48, 0x74, 0xbd, 0x21, 0x46, 0x62, 0x28, 0x27, 0x3d, 0x85, 0xc6, 0xc4, 0x99, 0xa1, 0x51, 0x37,
+	0x17, 0x5f, 0xdf, 0xc2, 0xf5, 0x89, 0x93, 0x20, 0xd2, 0x42, 0x85, 0xc9, 0xd7, 0xbf, 0x87, 0xc6,
+	0xac, 0x77, 0x52, 0x96, 0x53, 0x27, 0xb9, 0x9c, 0xaa, 0x7b, 0xfb, 0xbf, 0xce, 0x21, 0xac, 0xc3,
+	0xe4, 0x1a, 0xfc, 0x6f, 0x96, 0x94, 0x22, 0xff, 0x54, 0xa1, 0x7c, 0xa6, 0xbe, 0x51, 0xbb, 0xdf,
+	0xaa, 0xd2, 0x2d, 0x54, 0x81, 0xd2, 0xe1, 0xbb, 0x81, 0xd2, 0x97, 0x72, 0x08, 0x60, 0xa9, 0x3f,
+	0xc0, 0x1d, 0xf5, 0x6b, 0x29, 0x4f, 0xe1, 0x7e, 0x47, 0x1d, 0xbc, 0x92, 0x0a, 0x0c, 0xee, 0xa8,
+	0x83, 0xcf, 0x5e, 0x4a, 0xc5, 0xe8, 0x7b, 0x7f, 0x4f, 0x2a, 0x45, 0xdf, 0x2f, 0x5f, 0x48, 0x4b,
+	0x94, 0x7e, 0xc6, 0xe8, 0x65, 0x0a, 0x9f, 0x71, 0xfa, 0x72, 0xf4, 0xbd, 0xbf, 0x27, 0x55, 0xa2,
+	0xef, 0x97, 0x2f, 0x24, 0x68, 0xfd, 0x9c, 0x83, 0x5a, 0xf2, 0x42, 0x70, 0xe3, 0xd1, 0x90, 0x24,
+	0x27, 0x52, 0xe5, 0x3d, 0x58, 0x0a, 0x5c, 0xe3, 0x72, 0x68, 0x8a, 0xc4, 0x2f, 0x5a, 0xb4, 0x34,
+	0xd5, 0x4d, 0xd3, 0x9f, 0xde, 0xa4, 0x36, 0xb3, 0x2c, 0x1e, 0x70, 0x1a, 0x8e, 0xf8, 0xd4, 0xa4,
+	0x4f, 0x82, 0x89, 0x1d, 0xb2, 0xfc, 0x89, 0xb0, 0x68, 0xd1, 0x8d, 0x7a, 0xae, 0x1b, 0x97, 0xb6,
+	0x3b, 0x12, 0x07, 0x45, 0xd4, 0xa4, 0xb5, 0x80, 0xe9, 0x04, 0xda, 0xf7, 0x13, 0xe2, 0x5f, 0xf3,
+	0xc4, 0xd4, 0xe0, 0x79, 0xcd, 0x74, 0x82, 0x6f, 0x28, 0x48, 0xf3, 0x52, 0xeb, 0x7f, 0xf3, 0xb0,
+	0x32, 0x77, 0x89, 0x41, 0xaf, 0x66, 0x66, 0xfd, 0xf8, 0xa6, 0x4b, 0x4f, 0x62, 0xe2, 0x9b, 0x50,
+	0x1d, 0xbb, 0xe6, 0xc4, 0x16, 0x15, 0x1e, 0x3f, 0x26, 0x80, 0x43, 0x51, 0xae, 0x15, 0x04, 0xba,
+	0xd7, 0x43, 0xee, 0x87, 0x3a, 0xae, 0x71, 0x70, 0xc0, 0x30, 0x9a, 0x6b, 0xcf, 0xbd, 0xa1, 0x66,
+	0x8c, 0x79, 0x19, 0x58, 0xc2, 0x4b, 0xe7, 0xde, 0xb0, 0x3d, 0x66, 0x85, 0x0b, 0x15, 0x78, 0xbe,
+	0x3b, 0xe2, 0xfb, 0xf2, 0x2e, 0xd3, 0xae, 0x9e, 0x7b, 0xc3, 0x9e, 0xef, 0x8e, 0xd8, 0x2a, 0x6a,
+	0x42, 0x8d, 0x72, 0x2c, 0x27, 0x70, 0x34, 0xc3, 0x09, 0xe5, 0x7b, 0x8c, 0x02, 0xe7, 0xde, 0xb0,
+	0xe3, 0x04, 0x4e, 0xdb, 0x09, 0x67, 0xac, 0xb0, 0x61, 0xae, 0xf1, 0x04, 0x24, 0xac, 0x30, 0xb7,
+	0xfc, 0x5f, 0x11, 0x1a, 0xb3, 0x17, 0xb3, 0x1b, 0x8b, 0xe4, 0x59, 0x7a, 0xc2, 0x29, 0xf2, 0xec,
+	0xd5, 0xbb, 0x32, 0xbd, 0x59, 0xaf, 0x41, 0xd9, 0x27, 0xba, 0x1d, 0x95, 0x60, 0x2c, 0xaa, 0xba,
+	0xcd, 0xb3, 0x28, 0x19, 0x0e, 0x89, 0x11, 0x5a, 0x57, 0xac, 0x8e, 0xe6, 0x31, 0xaf, 0xc6, 0x58,
+	0xc7, 0xa4, 0x07, 0x5a, 0xa0, 0x5f, 0x11, 0x56, 0xbf, 0xd5, 0x99, 0xb8, 0xcc, 0xda, 0x1d, 0x13,
+	0xdd, 0x86, 0xd2, 0x90, 0x65, 0xe7, 0x06, 0xc3, 0x8b, 0x43, 0x9a, 0x98, 0xd7, 0xa0, 0x6c, 0xe8,
+	0x5e, 0xa2, 0xb6, 0x5e, 0x32, 0x74, 0x8f, 0x56, 0x83, 0x5b, 0x50, 0xa7, 0x82, 0xd8, 0x36, 0x73,
+	0x6a, 0x11, 0xd7, 0x0c, 0xdd, 0x53, 0x22, 0x2c, 0x22, 0x79, 0xc4, 0x1f, 0x5b, 0x61, 0x48, 0x4c,
+	0xf9, 0x5e, 0x4c, 0xea, 0x45, 0x18, 0x7b, 0xc9, 0xd0, 0x3d, 0xcd, 0x72, 0x2e, 0x88, 0x6f, 0x85,
+	0xfa, 0xb9, 0xcd, 0x5d, 0x4b, 0x5f, 0x32, 0x74, 0xaf, 0x33, 0x45, 0x17, 0x8b, 0xcb, 0x87, 0x1f,
+	0x5a, 0x5c, 0x6e, 0xa6, 0x17, 0x97, 0xc9, 0xda, 0x64, 0xfb, 0xbd, 0xb5, 0xc9, 0x27, 0x6c, 0x41,
+	0x25, 0x6a, 0x93, 0x69, 0xf9, 0xe1, 0xda, 0xe6, 0x74, 0x44, 0x3b, 0xc9, 0xf2, 0xa3, 0x6b, 0x9b,
+	0xd1, 0xb0, 0x5a, 0xbf, 0xe4, 0xa1, 0x9a, 0xb8, 0x74, 0xa3, 0x17, 0x33, 0xcb, 0xa2, 0xf9, 0xbe,
+	0x0b, 0xfa, 0xec, 0x9a, 0x88, 0x32, 0x01, 0x4f, 0x11, 0xc9, 0x8d, 0x6e, 0x13, 0x67, 0x14, 0x5e,
+	0x88, 0x02, 0x51, 0xb4, 0x68, 0x99, 0x47, 0xdf, 0x10, 0xd9, 0x52, 0x28, 0x61, 0xf6, 0x4d, 0xa7,
+	0x16, 0xd2, 0xab, 0xe5, 0xf4, 0xca, 0x54, 0xc2, 0x15, 0x8e, 0xd0, 0xc8, 0xee, 0xc0, 0x6a, 0x24,
+	0x9e, 0x9e, 0xd8, 0x77, 0xd9, 0xc4, 0x56, 0x04, 0x2b, 0x3e, 0xb7, 0x9f, 0x40, 0xc3, 0x0b, 0x7d,
+	0xdd, 0x20, 0x9a, 0x4f, 0x9f, 0xf8, 0x02, 0xbe, 0x71, 0x10, 0xae, 0x73, 0x14, 0x73, 0x30, 0x41,
+	0x8b, 0x86, 0xcf, 0x23, 0x2c, 0x68, 0x22, 0x6d, 0x51, 0x9a, 0x4f, 0xc6, 0x6e, 0x38, 0xa5, 0xc9,
+	0x9c, 0xc6, 0xd1, 0x88, 0xb6, 0x0d, 0x92, 0xa0, 0x59, 0xee, 0x95, 0x78, 0xfb, 0xba, 0xcf, 0x57,
+	0x0c, 0xc7, 0x3b, 0xee, 0x15, 0x7b, 0xfb, 0x6a, 0x69, 0x00, 0xd3, 0x17, 0x07, 0xea, 0x0b, 0x7f,
+	0x62, 0x47, 0x85, 0x1c, 0xfb, 0xa6, 0x17, 0x5f, 0x93, 0x04, 0x86, 0x6f, 0x79, 0xf4, 0xe4, 0x11,
+	0x55, 0x4b, 0x12, 0x62, 0x37, 0x10, 0xaa, 0xae, 0x59, 0x66, 0x20, 0x17, 0xd8, 0x42, 0x5a, 0x66,
+	0x40, 0xc7, 0x0c, 0x5a, 0x7f, 0x2d, 0x02, 0x5a, 0x7c, 0x9c, 0xa0, 0x09, 0xcd, 0xf0, 0x26, 0x74,
+	0xdd, 0x1b, 0xc4, 0x09, 0x59, 0x87, 0x39, 0x0c, 0x86, 0x37, 0xe9, 0x71, 0x84, 0x1a, 0xf5, 0x83,
+	0x40, 0x63, 0x67, 0x38, 0xeb, 0xb4, 0x88, 0x97, 0xfd, 0x20, 0x38, 0xa4, 0x6d, 0xb6, 0x84, 0xa9,
+	0x99, 0x80, 0x1a, 0xd0, 0xf8, 0x05, 0x9c, 0x9d, 0xe9, 0x39, 0xbc, 0xc2, 0x05, 0x3d, 0xe2, 0xf7,
+	0x19, 0x8c, 0x1e, 0x43, 0x3d, 0x98, 0x9c, 0xc7, 0xa3, 0x0d, 0xd8, 0xb1, 0x5e, 0xc2, 0xb3, 0x20,
+	0x1d, 0x8f, 0x7b, 0x45, 0x7c, 0xed, 0x7c, 0x62, 0x8e, 0x48, 0xc8, 0xce, 0xf4, 0x65, 0x0c, 0x14,
+	0x3a, 0x64, 0x08, 0xbd, 0x9f, 0x06, 0x17, 0xc4, 0xd4, 0xc4, 0xe3, 0x15, 0xef, 0x85, 0xbd, 0xe1,
+	0xd6, 0xb1, 0x44, 0x25, 0x3c, 0x79, 0x8b, 0xb7, 0x92, 0xcd, 0xd9, 0x07, 0x97, 0x32, 0x1b, 0x7f,
+	0xf2, 0x31, 0xc5, 0x81, 0xbb, 0x09, 0x82, 0x76, 0x7e, 0xad, 0x05, 0xee, 0xc4, 0x37, 0xa2, 0x27,
+	0xdb, 0x2f, 0x3f, 0xe0, 0x81, 0x27, 0xf1, 0x5c, 0x73, 0x78, 0xdd, 0x67, 0xda, 0xbc, 0xf8, 0x42,
+	0xf6, 0x82, 0x00, 0x11, 0x40, 0x73, 0xfd, 0xd1, 0x87, 0x95, 0x0a, 0xeb, 0xec, 0xd5, 0xaf, 0xed,
+	0xac, 0xed, 0x4d, 0x78, 0x4f, 0x2b, 0xf6, 0x2c, 0xba, 0xae, 0xc0, 0x5a, 0xc6, 0xa8, 0x6e, 0xaa,
+	0xa1, 0x8b, 0x89, 0xfa, 0x65, 0xfd, 0x10, 0xee, 0xa4, 0xf5, 0x97, 0xb4, 0x51, 0xba, 0xc1, 0x46,
+	0xeb, 0x2b, 0x58, 0x99, 0x7b, 0xcf, 0xa2, 0x64, 0xbe, 0x17, 0xc4, 0x13, 0x11, 0x6b, 0xf0, 0xa2,
+	0x82, 0xf9, 0x9e, 0xaf, 0x6d, 0xd1, 0xda, 0xf9, 0x63, 0x0e, 0xd0, 0xe2, 0x03, 0x0d, 0x6a, 0xc2,
+	0x47, 0xed, 0xae, 0x3a, 0x38, 0xe8, 0xa8, 0x0a, 0xd6, 0x94, 0xb7, 0x8a, 0x3a, 0xd0, 0x06, 0xef,
+	0x7a, 0x8a, 0x36, 0x2d, 0xb1, 0xb2, 0x18, 0x6d, 0xac, 0x1c, 0x0c, 0x94, 0x23, 0x29, 0x97, 0xc9,
+	0xc0, 0x67, 0xaa, 0xca, 0xeb, 0xb1, 0x4d, 0xd8, 0x48, 0x65, 0x28, 0xdf, 0x75, 0xa8, 0x89, 0x02,
+	0x6a, 0xc1, 0xc3, 0x54, 0xc2, 0x91, 0xd2, 0x1f, 0xe0, 0xee, 0x3b, 0xe5, 0x48, 0x2a, 0xee, 0xfc,
+	0x57, 0x0e, 0xa4, 0xf9, 0x07, 0x0d, 0xf4, 0x10, 0xd6, 0x7b, 0xb8, 0xdb, 0x56, 0xfa, 0xfd, 0xf4,
+	0xd1, 0x6f, 0xc0, 0x5a, 0x8a, 0xfc, 0xb8, 0x8b, 0xdf, 0x48, 0xb9, 0x0c, 0xa1, 0xf2, 0x9d, 0xd2,
+	0x96, 0xf2, 0x99, 0xc2, 0xce, 0x40, 0x2a, 0xec, 0x8c, 0x41, 0x9a, 0xbf, 0xef, 0xd3, 0xa1, 0xf4,
+	0xdf, 0xf5, 0xdb, 0x07, 0x27, 0x27, 0xe9, 0x43, 0xf9, 0x08, 0xe4, 0x14, 0xb9, 0xa2, 0x0e, 0x14,
+	0xcc, 0xc7, 0x92, 0x26, 0xa5, 0xdd, 0xe5, 0x77, 0xfe, 0x3f, 0x07, 0xf5, 0x99, 0xcb, 0x36, 0xa5,
+	0x1f, 0x77, 0x4e, 0x94, 0xf4, 0x9e, 0x64, 0xb8, 0x33, 0x2f, 0xec, 0xf6, 0x14, 0x55, 0xca, 0xa1,
+	0x75, 0xb8, 0xb7, 0xa8, 0x76, 0xd2, 0x51, 0xdf, 0x48, 0xf9, 0x34, 0x19, 0x56, 0xd4, 0x83, 0x53,
+	0x45, 0x2a, 0xa0, 0xfb, 0x70, 0x77, 0x5e, 0xd6, 0x7e, 0x7d, 0xda, 0xa5, 0x61, 0xf9, 0x5d, 0x0e,
+	0x36, 0x32, 0xea, 0x79, 0x36, 0xd2, 0x7f, 0x82, 0xa7, 0x6f, 0x14, 0xac, 0x2a, 0x27, 0xda, 0xf1,
+	0x99, 0xda, 0x1e, 0x74, 0xba, 0xaa, 0x96, 0xed, 0xa3, 0x4f, 0xe0, 0xc9, 0x4d, 0xe4, 0xc8, 0x61,
+	0xdb, 0xf0, 0xf8, 0x46, 0x2a, 0xf7, 0xde, 0xef, 0x8b, 0x20, 0xcd, 0x97, 0xe0, 0x34, 0x5a, 0xaa,
+	0x32, 0xf8, 0xb6, 0x8b, 0xdf, 0xa4, 0x8f, 0xe4, 0x63, 0x68, 0xa5, 0xc8, 0xdb, 0x5d, 0x55, 0x55,
+	0xda, 0x03, 0xed, 0x60, 0x30, 0x50, 0x4e, 0x7b, 0x03, 0x29, 0x87, 0x9e, 0xc0, 0xa3, 0xf7, 0xf0,
+	0xb0, 0xd2, 0x3f, 0x3b, 0x19, 0x48, 0x79, 0xb4, 0x05, 0x9b, 0x29, 0xb4, 0xc3, 0x8e, 0x7a, 0x14,
+	0xdb, 0x62, 0xbb, 0x20, 0x8b, 0x24, 0x0c, 0x15, 0x33, 0xfa, 0x3b, 0xe9, 0xf4, 0x07, 0x8a, 0x1a,
+	0x9b, 0x2a, 0xa1, 0xc7, 0xd0, 0xcc, 0xa6, 0x09, 0x63, 0x4b, 0x19, 0xc6, 0x0e, 0xda, 0x6d, 0xa5,
+	0x37, 0x9d, 0x63, 0x39, 0xc3, 0x98, 0xa0, 0x09, 0x63, 0xcb, 0x19, 0xc6, 0xfa, 0x8a, 0x7a, 0x34,
+	0xe8, 0xc6, 0xc6, 0x2a, 0x19, 0xc6, 0x04, 0x4d, 0x18, 0x03, 0xf4, 0x14, 0xb6, 0x52, 0x58, 0x58,
+	0x69, 0xbf, 0x3d, 0xc6, 0xdd, 0xd3, 0xd8, 0x5c, 0x35, 0x23, 0x4e, 0x31, 0x51, 0x18, 0xac, 0xd1,
+	0x24, 0x95, 0xc2, 0x3b, 0x52, 0xfb, 0xda, 0x37, 0x67, 0x0a, 0x7e, 0x27, 0xd5, 0x77, 0xfe, 0x13,
+	0x6e, 0xa7, 0x5c, 0x59, 0x68, 0x50, 0xc4, 0x3a, 0x3b, 0xe9, 0x1e, 0x1c, 0xa5, 0x2f, 0x96, 0x47,
+	0xf0, 0x20, 0x83, 0x73, 0xda, 0x3d, 0x3a, 0x3b, 0x51, 0xa4, 0x1c, 0x5d, 0x6f, 0x19, 0x94, 0xc3,
+	0xde, 0xb1, 0x94, 0xdf, 0xf9, 0x29, 0x07, 0x68, 0xf1, 0x6e, 0x40, 0x87, 0xdd, 0xc3, 0x9d, 0xb7,
+	0x9d, 0x13, 0xe5, 0xeb, 0x8c, 0xcd, 0xbe, 0x09, 0x1b, 0xa9, 0x8c, 0xbe, 0x32, 0x38, 0xeb, 0xd0,
+	0xf4, 0xfc, 0x1e, 0xc2, 0xd7, 0x9d, 0x23, 0x29, 0x9f, 0x49, 0x68, 0x1f, 0xf4, 0xfa, 0x0a, 0x5d,
+	0x97, 0x0f, 0xe0, 0x7e, 0x2a, 0x81, 0x65, 0xca, 0x22, 0xfa, 0x14, 0xb6, 0x53, 0xc5, 0x07, 0xed,
+	0x76, 0xf7, 0x4c, 0x1d, 0x68, 0x2c, 0x73, 0x9c, 0x76, 0x8f, 0x3a, 0xc7, 0xef, 0xa4, 0xd2, 0xce,
+	0x6f, 0x73, 0xb0, 0x32, 0x57, 0xed, 0xd2, 0x0e, 0x4e, 0x95, 0xd3, 0x2e, 0x7e, 0x97, 0x3e, 0xc5,
+	0x2d, 0xd8, 0x5c, 0x14, 0x9f, 0xf6, 0x70, 0x77, 0x40, 0xf7, 0x18, 0x1b, 0x45, 0x8e, 0xa6, 0xd7,
+	0x45, 0x52, 0x6f, 0x80, 0x0f, 0xda, 0x8a, 0x94, 0xa7, 0xeb, 0x29, 0x45, 0x2a, 0xf2, 0xfb, 0xdb,
+	0x53, 0xed, 0x5b, 0xdc, 0x19, 0x28, 0x6f, 0xa5, 0xc2, 0xe1, 0x93, 0x7f, 0xdf, 0x1a, 0x59, 0xe1,
+	0xc5, 0xe4, 0x7c, 0xd7, 0x70, 0xc7, 0xcf, 0xe2, 0xbf, 0xf4, 0x73, 0xbf, 0xeb, 0xcf, 0x97, 0xd8,
+	0x9f, 0xfa, 0xfd, 0xbf, 0x0f, 0x00, 0x25, 0xa8, 0x25, 0xff, 0xf6, 0x1f, 0x00, 0x00,
 }
@@ -0,0 +1,236 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v0
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// SchemaVersion is the version of the event schema defined by this package.
+// It must be incremented whenever a change to the API protos is not
+// backward compatible, i.e. whenever CheckCompatibility reports a problem
+// against the previous release.
+const SchemaVersion = 1
+
+// schemaFiles are the proto files that make up the API.
+var schemaFiles = []string{
+	"capsule8/api/v0/types.proto",
+	"capsule8/api/v0/event.proto",
+	"capsule8/api/v0/telemetry_service.proto",
+	"capsule8/api/v0/subscription.proto",
+	"capsule8/api/v0/expression.proto",
+	"capsule8/api/v0/state_service.proto",
+}
+
+func fileDescriptorProto(name string) (*descriptor.FileDescriptorProto, error) {
+	gz := proto.FileDescriptor(name)
+	if gz == nil {
+		return nil, fmt.Errorf("Proto file %s is not registered", name)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &descriptor.FileDescriptorProto{}
+	if err = proto.Unmarshal(b, fd); err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
+
+// FileDescriptorSet returns the descriptors of the API proto files and all
+// of the files that they depend on, with dependencies ordered before the
+// files that import them.
+func FileDescriptorSet() (*descriptor.FileDescriptorSet, error) {
+	set := &descriptor.FileDescriptorSet{}
+	seen := make(map[string]bool)
+
+	var add func(name string) error
+	add = func(name string) error {
+		if seen[name] {
+			return nil
+		}
+		seen[name] = true
+
+		fd, err := fileDescriptorProto(name)
+		if err != nil {
+			return err
+		}
+		for _, dep := range fd.Dependency {
+			if err = add(dep); err != nil {
+				return err
+			}
+		}
+		set.File = append(set.File, fd)
+		return nil
+	}
+
+	for _, name := range schemaFiles {
+		if err := add(name); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
+
+func fieldType(f *descriptor.FieldDescriptorProto) string {
+	t := f.GetType().String()
+	if len(f.GetTypeName()) > 0 {
+		t = f.GetTypeName()
+	}
+	if f.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		t = "repeated " + t
+	}
+	return t
+}
+
+type schemaIndex struct {
+	messages map[string]*descriptor.DescriptorProto
+	enums    map[string]*descriptor.EnumDescriptorProto
+}
+
+func indexMessages(index *schemaIndex, prefix string, messages []*descriptor.DescriptorProto, enums []*descriptor.EnumDescriptorProto) {
+	for _, e := range enums {
+		index.enums[prefix+"."+e.GetName()] = e
+	}
+	for _, m := range messages {
+		name := prefix + "." + m.GetName()
+		index.messages[name] = m
+		indexMessages(index, name, m.NestedType, m.EnumType)
+	}
+}
+
+func newSchemaIndex(set *descriptor.FileDescriptorSet) *schemaIndex {
+	index := &schemaIndex{
+		messages: make(map[string]*descriptor.DescriptorProto),
+		enums:    make(map[string]*descriptor.EnumDescriptorProto),
+	}
+	for _, fd := range set.File {
+		prefix := ""
+		if len(fd.GetPackage()) > 0 {
+			prefix = "." + fd.GetPackage()
+		}
+		indexMessages(index, prefix, fd.MessageType, fd.EnumType)
+	}
+	return index
+}
+
+// CheckCompatibility compares two schemas, such as the one returned by a
+// Sensor's GetSchema and the one compiled into a client, and describes each
+// way in which data encoded with the older schema would be misinterpreted
+// by the newer one: fields that were renumbered, field numbers that were
+// reused with a different name or type, and enum values that were
+// renumbered or whose numbers were reused. Removing fields, enum values,
+// or messages and adding new ones are compatible changes. An empty result
+// means the schemas are compatible.
+func CheckCompatibility(older, newer *descriptor.FileDescriptorSet) []string {
+	var problems []string
+	o := newSchemaIndex(older)
+	n := newSchemaIndex(newer)
+
+	for name, om := range o.messages {
+		nm, ok := n.messages[name]
+		if !ok {
+			continue
+		}
+
+		byNumber := make(map[int32]*descriptor.FieldDescriptorProto)
+		byName := make(map[string]*descriptor.FieldDescriptorProto)
+		for _, f := range nm.Field {
+			byNumber[f.GetNumber()] = f
+			byName[f.GetName()] = f
+		}
+
+		for _, of := range om.Field {
+			if nf, ok := byNumber[of.GetNumber()]; ok {
+				if nf.GetName() != of.GetName() ||
+					fieldType(nf) != fieldType(of) {
+					problems = append(problems, fmt.Sprintf(
+						"%s field %d changed from %s (%s) to %s (%s)",
+						name[1:], of.GetNumber(),
+						of.GetName(), fieldType(of),
+						nf.GetName(), fieldType(nf)))
+				}
+			} else if nf, ok := byName[of.GetName()]; ok {
+				problems = append(problems, fmt.Sprintf(
+					"%s field %s renumbered from %d to %d",
+					name[1:], of.GetName(), of.GetNumber(),
+					nf.GetNumber()))
+			}
+		}
+	}
+
+	for name, oe := range o.enums {
+		ne, ok := n.enums[name]
+		if !ok {
+			continue
+		}
+
+		byNumber := make(map[int32]string)
+		byName := make(map[string]int32)
+		for _, v := range ne.Value {
+			byNumber[v.GetNumber()] = v.GetName()
+			byName[v.GetName()] = v.GetNumber()
+		}
+		for _, v := range oe.Value {
+			if number, ok := byName[v.GetName()]; ok {
+				if number != v.GetNumber() {
+					problems = append(problems, fmt.Sprintf(
+						"%s value %s renumbered from %d to %d",
+						name[1:], v.GetName(), v.GetNumber(),
+						number))
+				}
+			} else if vn, ok := byNumber[v.GetNumber()]; ok {
+				problems = append(problems, fmt.Sprintf(
+					"%s value %d changed from %s to %s",
+					name[1:], v.GetNumber(), v.GetName(), vn))
+			}
+		}
+	}
+
+	sort.Strings(problems)
+	return problems
+}
+
+// NewGetSchemaResponse returns a GetSchemaResponse describing the schema
+// defined by this package, for implementations of TelemetryService.
+func NewGetSchemaResponse() (*GetSchemaResponse, error) {
+	set, err := FileDescriptorSet()
+	if err != nil {
+		return nil, err
+	}
+	b, err := proto.Marshal(set)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetSchemaResponse{
+		SchemaVersion:     SchemaVersion,
+		FileDescriptorSet: b,
+	}, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v0
+
+import (
+	"flag"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// The schema of the last release, against which the current schema must
+// remain compatible. Update it with "go test -update" only when releasing
+// a new SchemaVersion.
+const releasedSchema = "testdata/schema-v1.txt"
+
+var update = flag.Bool("update", false, "update "+releasedSchema)
+
+// apiFiles returns only the API proto files from a FileDescriptorSet.
+func apiFiles(set *descriptor.FileDescriptorSet) *descriptor.FileDescriptorSet {
+	api := &descriptor.FileDescriptorSet{}
+	for _, fd := range set.File {
+		if strings.HasPrefix(fd.GetName(), "capsule8/") {
+			api.File = append(api.File, fd)
+		}
+	}
+	return api
+}
+
+func TestFileDescriptorSet(t *testing.T) {
+	set, err := FileDescriptorSet()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seen := make(map[string]bool)
+	for _, fd := range set.File {
+		for _, dep := range fd.Dependency {
+			if !seen[dep] {
+				t.Errorf("%s precedes its dependency %s",
+					fd.GetName(), dep)
+			}
+		}
+		seen[fd.GetName()] = true
+	}
+	for _, name := range schemaFiles {
+		if !seen[name] {
+			t.Errorf("Expected %s in FileDescriptorSet", name)
+		}
+	}
+}
+
+func TestSchemaCompatibility(t *testing.T) {
+	set, err := FileDescriptorSet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	current := apiFiles(set)
+
+	if *update {
+		err = ioutil.WriteFile(releasedSchema,
+			[]byte(proto.MarshalTextString(current)), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := ioutil.ReadFile(releasedSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	released := &descriptor.FileDescriptorSet{}
+	if err = proto.UnmarshalText(string(b), released); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, problem := range CheckCompatibility(released, current) {
+		t.Errorf("Incompatible with schema version %d: %s",
+			SchemaVersion, problem)
+	}
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	older := &descriptor.FileDescriptorSet{}
+	err := proto.UnmarshalText(`
+file: <
+  name: "test.proto"
+  package: "test"
+  message_type: <
+    name: "M"
+    field: < name: "a" number: 1 type: TYPE_STRING >
+    field: < name: "b" number: 2 type: TYPE_INT32 >
+    field: < name: "c" number: 3 type: TYPE_INT32 >
+    field: < name: "d" number: 4 type: TYPE_INT32 >
+  >
+  enum_type: <
+    name: "E"
+    value: < name: "X" number: 0 >
+    value: < name: "Y" number: 1 >
+    value: < name: "Z" number: 2 >
+  >
+>`, older)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newer := &descriptor.FileDescriptorSet{}
+	err = proto.UnmarshalText(`
+file: <
+  name: "test.proto"
+  package: "test"
+  message_type: <
+    name: "M"
+    field: < name: "a" number: 1 type: TYPE_STRING >
+    field: < name: "b" number: 5 type: TYPE_INT32 >
+    field: < name: "c" number: 3 type: TYPE_STRING >
+    field: < name: "e" number: 6 type: TYPE_INT32 >
+  >
+  enum_type: <
+    name: "E"
+    value: < name: "X" number: 0 >
+    value: < name: "Y" number: 2 >
+    value: < name: "W" number: 1 >
+  >
+>`, newer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"test.E value 2 changed from Z to Y",
+		"test.E value Y renumbered from 1 to 2",
+		"test.M field 3 changed from c (TYPE_INT32) to c (TYPE_STRING)",
+		"test.M field b renumbered from 2 to 5",
+	}
+	problems := CheckCompatibility(older, newer)
+	if strings.Join(problems, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expected problems:\n%s\ngot:\n%s",
+			strings.Join(expected, "\n"), strings.Join(problems, "\n"))
+	}
+
+	if problems = CheckCompatibility(older, older); len(problems) > 0 {
+		t.Errorf("Expected no problems, got %v", problems)
+	}
+}
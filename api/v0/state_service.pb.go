@@ -0,0 +1,417 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: capsule8/api/v0/state_service.proto
+
+package v0
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// A request message for the processes running on the Node
+type GetProcessesRequest struct {
+	// Optional; only return processes running in this container
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId" json:"container_id,omitempty"`
+}
+
+func (m *GetProcessesRequest) Reset()                    { *m = GetProcessesRequest{} }
+func (m *GetProcessesRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetProcessesRequest) ProtoMessage()               {}
+func (*GetProcessesRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0} }
+
+func (m *GetProcessesRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+// A process running on the Node
+type ProcessState struct {
+	// The process, as it would appear in an Event's process lineage
+	Process *Process `protobuf:"bytes,1,opt,name=process" json:"process,omitempty"`
+	// Unix pid of the process's parent
+	ParentPid int32 `protobuf:"zigzag32,2,opt,name=parent_pid,json=parentPid" json:"parent_pid,omitempty"`
+	// Unique process identifier of the process's parent
+	ParentProcessId string `protobuf:"bytes,3,opt,name=parent_process_id,json=parentProcessId" json:"parent_process_id,omitempty"`
+}
+
+func (m *ProcessState) Reset()                    { *m = ProcessState{} }
+func (m *ProcessState) String() string            { return proto.CompactTextString(m) }
+func (*ProcessState) ProtoMessage()               {}
+func (*ProcessState) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{1} }
+
+func (m *ProcessState) GetProcess() *Process {
+	if m != nil {
+		return m.Process
+	}
+	return nil
+}
+
+func (m *ProcessState) GetParentPid() int32 {
+	if m != nil {
+		return m.ParentPid
+	}
+	return 0
+}
+
+func (m *ProcessState) GetParentProcessId() string {
+	if m != nil {
+		return m.ParentProcessId
+	}
+	return ""
+}
+
+// A response message describing the processes running on the Node. Each
+// process refers to its parent, so together they describe the process tree.
+type GetProcessesResponse struct {
+	Processes []*ProcessState `protobuf:"bytes,1,rep,name=processes" json:"processes,omitempty"`
+}
+
+func (m *GetProcessesResponse) Reset()                    { *m = GetProcessesResponse{} }
+func (m *GetProcessesResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetProcessesResponse) ProtoMessage()               {}
+func (*GetProcessesResponse) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{2} }
+
+func (m *GetProcessesResponse) GetProcesses() []*ProcessState {
+	if m != nil {
+		return m.Processes
+	}
+	return nil
+}
+
+// A request message for the containers known to the Sensor
+type GetContainersRequest struct {
+}
+
+func (m *GetContainersRequest) Reset()                    { *m = GetContainersRequest{} }
+func (m *GetContainersRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetContainersRequest) ProtoMessage()               {}
+func (*GetContainersRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{3} }
+
+// A container known to the Sensor
+type ContainerState struct {
+	// Container identifier, as in Event.container_id
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId" json:"container_id,omitempty"`
+	// Name of the container
+	ContainerName string `protobuf:"bytes,2,opt,name=container_name,json=containerName" json:"container_name,omitempty"`
+	// Unique identifier of the container image
+	ImageId string `protobuf:"bytes,3,opt,name=image_id,json=imageId" json:"image_id,omitempty"`
+	// Name of the container image
+	ImageName string `protobuf:"bytes,4,opt,name=image_name,json=imageName" json:"image_name,omitempty"`
+	// Kubernetes metadata of the container, if it belongs to a pod
+	Kubernetes *KubernetesMetadata `protobuf:"bytes,5,opt,name=kubernetes" json:"kubernetes,omitempty"`
+	// Unix pids of the processes currently running in the container
+	Pids []int32 `protobuf:"zigzag32,6,rep,packed,name=pids" json:"pids,omitempty"`
+}
+
+func (m *ContainerState) Reset()                    { *m = ContainerState{} }
+func (m *ContainerState) String() string            { return proto.CompactTextString(m) }
+func (*ContainerState) ProtoMessage()               {}
+func (*ContainerState) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{4} }
+
+func (m *ContainerState) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *ContainerState) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *ContainerState) GetImageId() string {
+	if m != nil {
+		return m.ImageId
+	}
+	return ""
+}
+
+func (m *ContainerState) GetImageName() string {
+	if m != nil {
+		return m.ImageName
+	}
+	return ""
+}
+
+func (m *ContainerState) GetKubernetes() *KubernetesMetadata {
+	if m != nil {
+		return m.Kubernetes
+	}
+	return nil
+}
+
+func (m *ContainerState) GetPids() []int32 {
+	if m != nil {
+		return m.Pids
+	}
+	return nil
+}
+
+// A response message describing the containers known to the Sensor
+type GetContainersResponse struct {
+	Containers []*ContainerState `protobuf:"bytes,1,rep,name=containers" json:"containers,omitempty"`
+}
+
+func (m *GetContainersResponse) Reset()                    { *m = GetContainersResponse{} }
+func (m *GetContainersResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetContainersResponse) ProtoMessage()               {}
+func (*GetContainersResponse) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{5} }
+
+func (m *GetContainersResponse) GetContainers() []*ContainerState {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+// A request message for recent events
+type GetRecentEventsRequest struct {
+	// Optional; only return events associated with this container
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId" json:"container_id,omitempty"`
+	// Optional; the maximum number of events to return, most recent
+	// last. If zero, all buffered events are returned.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *GetRecentEventsRequest) Reset()                    { *m = GetRecentEventsRequest{} }
+func (m *GetRecentEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRecentEventsRequest) ProtoMessage()               {}
+func (*GetRecentEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{6} }
+
+func (m *GetRecentEventsRequest) GetContainerId() string {
+	if m != nil {
+		return m.ContainerId
+	}
+	return ""
+}
+
+func (m *GetRecentEventsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// A response message containing recent events
+type GetRecentEventsResponse struct {
+	Events []*Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+}
+
+func (m *GetRecentEventsResponse) Reset()                    { *m = GetRecentEventsResponse{} }
+func (m *GetRecentEventsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetRecentEventsResponse) ProtoMessage()               {}
+func (*GetRecentEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{7} }
+
+func (m *GetRecentEventsResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetProcessesRequest)(nil), "capsule8.api.v0.GetProcessesRequest")
+	proto.RegisterType((*ProcessState)(nil), "capsule8.api.v0.ProcessState")
+	proto.RegisterType((*GetProcessesResponse)(nil), "capsule8.api.v0.GetProcessesResponse")
+	proto.RegisterType((*GetContainersRequest)(nil), "capsule8.api.v0.GetContainersRequest")
+	proto.RegisterType((*ContainerState)(nil), "capsule8.api.v0.ContainerState")
+	proto.RegisterType((*GetContainersResponse)(nil), "capsule8.api.v0.GetContainersResponse")
+	proto.RegisterType((*GetRecentEventsRequest)(nil), "capsule8.api.v0.GetRecentEventsRequest")
+	proto.RegisterType((*GetRecentEventsResponse)(nil), "capsule8.api.v0.GetRecentEventsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for StateService service
+
+type StateServiceClient interface {
+	// Returns the processes currently running on the Node
+	GetProcesses(ctx context.Context, in *GetProcessesRequest, opts ...grpc.CallOption) (*GetProcessesResponse, error)
+	// Returns the containers currently known to the Sensor
+	GetContainers(ctx context.Context, in *GetContainersRequest, opts ...grpc.CallOption) (*GetContainersResponse, error)
+	// Returns the most recent events sent to telemetry subscribers
+	GetRecentEvents(ctx context.Context, in *GetRecentEventsRequest, opts ...grpc.CallOption) (*GetRecentEventsResponse, error)
+}
+
+type stateServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStateServiceClient(cc *grpc.ClientConn) StateServiceClient {
+	return &stateServiceClient{cc}
+}
+
+func (c *stateServiceClient) GetProcesses(ctx context.Context, in *GetProcessesRequest, opts ...grpc.CallOption) (*GetProcessesResponse, error) {
+	out := new(GetProcessesResponse)
+	err := grpc.Invoke(ctx, "/capsule8.api.v0.StateService/GetProcesses", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stateServiceClient) GetContainers(ctx context.Context, in *GetContainersRequest, opts ...grpc.CallOption) (*GetContainersResponse, error) {
+	out := new(GetContainersResponse)
+	err := grpc.Invoke(ctx, "/capsule8.api.v0.StateService/GetContainers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stateServiceClient) GetRecentEvents(ctx context.Context, in *GetRecentEventsRequest, opts ...grpc.CallOption) (*GetRecentEventsResponse, error) {
+	out := new(GetRecentEventsResponse)
+	err := grpc.Invoke(ctx, "/capsule8.api.v0.StateService/GetRecentEvents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for StateService service
+
+type StateServiceServer interface {
+	// Returns the processes currently running on the Node
+	GetProcesses(context.Context, *GetProcessesRequest) (*GetProcessesResponse, error)
+	// Returns the containers currently known to the Sensor
+	GetContainers(context.Context, *GetContainersRequest) (*GetContainersResponse, error)
+	// Returns the most recent events sent to telemetry subscribers
+	GetRecentEvents(context.Context, *GetRecentEventsRequest) (*GetRecentEventsResponse, error)
+}
+
+func RegisterStateServiceServer(s *grpc.Server, srv StateServiceServer) {
+	s.RegisterService(&_StateService_serviceDesc, srv)
+}
+
+func _StateService_GetProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).GetProcesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/capsule8.api.v0.StateService/GetProcesses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).GetProcesses(ctx, req.(*GetProcessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StateService_GetContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).GetContainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/capsule8.api.v0.StateService/GetContainers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).GetContainers(ctx, req.(*GetContainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StateService_GetRecentEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).GetRecentEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/capsule8.api.v0.StateService/GetRecentEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).GetRecentEvents(ctx, req.(*GetRecentEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _StateService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "capsule8.api.v0.StateService",
+	HandlerType: (*StateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProcesses",
+			Handler:    _StateService_GetProcesses_Handler,
+		},
+		{
+			MethodName: "GetContainers",
+			Handler:    _StateService_GetContainers_Handler,
+		},
+		{
+			MethodName: "GetRecentEvents",
+			Handler:    _StateService_GetRecentEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "capsule8/api/v0/state_service.proto",
+}
+
+func init() { proto.RegisterFile("capsule8/api/v0/state_service.proto", fileDescriptor5) }
+
+var fileDescriptor5 = []byte{
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x25, 0xeb, 0xd6, 0x91, 0xdb, 0x76, 0x55, 0xcd, 0x28, 0xa1, 0x68, 0xa2, 0xa4, 0x14, 0x22,
+	0x1e, 0xd2, 0xa9, 0xbc, 0x4c, 0xe2, 0x01, 0x89, 0x09, 0x4d, 0x15, 0x02, 0x0d, 0xf7, 0x05, 0x21,
+	0xa1, 0xe2, 0x26, 0x97, 0x61, 0xb1, 0x24, 0x26, 0x76, 0xfb, 0x17, 0x7c, 0x0f, 0x5f, 0xc5, 0x3f,
+	0xa0, 0xda, 0x49, 0xda, 0x26, 0x45, 0x15, 0x6f, 0xf1, 0xf5, 0x39, 0xf6, 0x39, 0x27, 0xd7, 0x17,
+	0x06, 0x01, 0x13, 0x72, 0x71, 0x8b, 0x17, 0x23, 0x26, 0xf8, 0x68, 0x79, 0x3e, 0x92, 0x8a, 0x29,
+	0x9c, 0x49, 0x4c, 0x97, 0x3c, 0x40, 0x5f, 0xa4, 0x89, 0x4a, 0x48, 0x3b, 0x07, 0xf9, 0x4c, 0x70,
+	0x7f, 0x79, 0xde, 0x7b, 0x54, 0x66, 0xe1, 0x12, 0x63, 0x65, 0xd0, 0xee, 0x05, 0xdc, 0xbb, 0x42,
+	0x75, 0x9d, 0x26, 0x01, 0x4a, 0x89, 0x92, 0xe2, 0xcf, 0x05, 0x4a, 0x45, 0x9e, 0x40, 0x33, 0x48,
+	0x62, 0xc5, 0x78, 0x8c, 0xe9, 0x8c, 0x87, 0x8e, 0xd5, 0xb7, 0x3c, 0x9b, 0x36, 0x8a, 0xda, 0x24,
+	0x74, 0x7f, 0x59, 0xd0, 0xcc, 0x78, 0xd3, 0x95, 0x0c, 0x32, 0x86, 0x63, 0x61, 0xd6, 0x1a, 0xde,
+	0x18, 0x3b, 0x7e, 0x49, 0x8a, 0x9f, 0xe1, 0x69, 0x0e, 0x24, 0x67, 0x00, 0x82, 0xa5, 0x18, 0xab,
+	0x99, 0xe0, 0xa1, 0x73, 0xd0, 0xb7, 0xbc, 0x0e, 0xb5, 0x4d, 0xe5, 0x9a, 0x87, 0xe4, 0x05, 0x74,
+	0xf2, 0x6d, 0x43, 0x58, 0x69, 0xa9, 0x69, 0x2d, 0xed, 0x0c, 0x65, 0xea, 0x93, 0xd0, 0x9d, 0xc2,
+	0xe9, 0xb6, 0x13, 0x29, 0x92, 0x58, 0x22, 0x79, 0x05, 0xb6, 0xc8, 0x8b, 0x8e, 0xd5, 0xaf, 0x79,
+	0x8d, 0xf1, 0xd9, 0xbf, 0x84, 0x69, 0x23, 0x74, 0x8d, 0x77, 0xbb, 0xfa, 0xd0, 0xcb, 0xdc, 0x76,
+	0x9e, 0x8f, 0xfb, 0xc7, 0x82, 0x93, 0xa2, 0x6a, 0xec, 0xef, 0x8f, 0x8c, 0x0c, 0xe1, 0x64, 0x0d,
+	0x89, 0x59, 0x84, 0xda, 0xb1, 0x4d, 0x5b, 0x45, 0xf5, 0x03, 0x8b, 0x90, 0x3c, 0x84, 0xbb, 0x3c,
+	0x62, 0x37, 0xb8, 0x36, 0x7b, 0xac, 0xd7, 0x93, 0x70, 0x95, 0x97, 0xd9, 0xd2, 0xec, 0x43, 0xbd,
+	0x69, 0xeb, 0x8a, 0x66, 0x5e, 0x02, 0xfc, 0x58, 0xcc, 0x31, 0x8d, 0x51, 0xa1, 0x74, 0x8e, 0xf4,
+	0x5f, 0x18, 0x54, 0xcc, 0xbe, 0x2b, 0x20, 0xef, 0x51, 0xb1, 0x90, 0x29, 0x46, 0x37, 0x68, 0x84,
+	0xc0, 0xa1, 0xe0, 0xa1, 0x74, 0xea, 0xfd, 0x9a, 0xd7, 0xa1, 0xfa, 0xdb, 0xfd, 0x04, 0xf7, 0x4b,
+	0x39, 0x64, 0xe9, 0xbe, 0x06, 0x28, 0xc4, 0xe7, 0xf1, 0x3e, 0xae, 0xdc, 0xb8, 0x1d, 0x15, 0xdd,
+	0xa0, 0xb8, 0x1f, 0xa1, 0x7b, 0x85, 0x8a, 0x62, 0x80, 0xb1, 0x7a, 0xbb, 0x6a, 0xcc, 0xff, 0xe8,
+	0x41, 0x72, 0x0a, 0x47, 0xb7, 0x3c, 0xe2, 0x4a, 0xe7, 0xd8, 0xa2, 0x66, 0xe1, 0x4e, 0xe0, 0x41,
+	0xe5, 0xc8, 0x4c, 0xae, 0x0f, 0x75, 0xdd, 0xfd, 0xb9, 0xd4, 0x6e, 0x45, 0xaa, 0x26, 0xd0, 0x0c,
+	0x35, 0xfe, 0x7d, 0x00, 0x4d, 0xad, 0x79, 0x6a, 0xde, 0x18, 0xf9, 0x02, 0xcd, 0xcd, 0x2e, 0x23,
+	0x4f, 0x2b, 0x07, 0xec, 0x78, 0x4e, 0xbd, 0xe1, 0x1e, 0x94, 0x51, 0xe7, 0xde, 0x21, 0x5f, 0xa1,
+	0xb5, 0x95, 0x33, 0xd9, 0xc9, 0xac, 0xf4, 0x63, 0xef, 0xd9, 0x3e, 0x58, 0x71, 0xc3, 0x37, 0x68,
+	0x97, 0xc2, 0x21, 0xcf, 0x77, 0x91, 0x77, 0xfc, 0x91, 0x9e, 0xb7, 0x1f, 0x98, 0xdf, 0xf3, 0x66,
+	0xf8, 0x79, 0x70, 0xc3, 0xd5, 0xf7, 0xc5, 0xdc, 0x0f, 0x92, 0x68, 0x54, 0x8c, 0xa0, 0xd2, 0x2c,
+	0x9a, 0xd7, 0xf5, 0x18, 0x7a, 0xf9, 0x77, 0x00, 0x61, 0xed, 0xea, 0xe5, 0xdb, 0x04, 0x00, 0x00,
+}
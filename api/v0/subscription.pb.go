@@ -70,13 +70,11 @@ func (x ThrottleModifier_IntervalType) String() string {
 	return proto.EnumName(ThrottleModifier_IntervalType_name, int32(x))
 }
 func (ThrottleModifier_IntervalType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor3, []int{12, 0}
+	return fileDescriptor3, []int{17, 0}
 }
 
-//
 // The Subscription message identifies a subscriber's interest in
 // telemetry events.
-//
 type Subscription struct {
 	// Return events matching one or more of the specified event
 	// filters. If no event filters are specified, then no events
@@ -206,8 +204,20 @@ type EventFilter struct {
 	KernelEvents []*KernelFunctionCallFilter `protobuf:"bytes,4,rep,name=kernel_events,json=kernelEvents" json:"kernel_events,omitempty"`
 	// Zero or more network events to include
 	NetworkEvents []*NetworkEventFilter `protobuf:"bytes,5,rep,name=network_events,json=networkEvents" json:"network_events,omitempty"`
+	// Zero or more kernel module and BPF program load events to include
+	KernelLoadEvents []*KernelLoadEventFilter `protobuf:"bytes,6,rep,name=kernel_load_events,json=kernelLoadEvents" json:"kernel_load_events,omitempty"`
+	// Zero or more privilege change events to include
+	PrivilegeEvents []*PrivilegeEventFilter `protobuf:"bytes,7,rep,name=privilege_events,json=privilegeEvents" json:"privilege_events,omitempty"`
+	// Zero or more memory protection and injection events to include
+	MemoryEvents []*MemoryEventFilter `protobuf:"bytes,8,rep,name=memory_events,json=memoryEvents" json:"memory_events,omitempty"`
 	// Zero or more container events to include
 	ContainerEvents []*ContainerEventFilter `protobuf:"bytes,10,rep,name=container_events,json=containerEvents" json:"container_events,omitempty"`
+	// Zero or more alerts from the Sensor's built-in detection rules
+	// to include
+	AlertEvents []*AlertEventFilter `protobuf:"bytes,50,rep,name=alert_events,json=alertEvents" json:"alert_events,omitempty"`
+	// Zero or more reports of the Sensor's own resource usage to
+	// include
+	MetricsEvents []*SensorMetricsEventFilter `protobuf:"bytes,51,rep,name=metrics_events,json=metricsEvents" json:"metrics_events,omitempty"`
 	// Zero or more character generators to configure and return events from
 	// (for debugging)
 	ChargenEvents []*ChargenEventFilter `protobuf:"bytes,100,rep,name=chargen_events,json=chargenEvents" json:"chargen_events,omitempty"`
@@ -256,6 +266,27 @@ func (m *EventFilter) GetNetworkEvents() []*NetworkEventFilter {
 	return nil
 }
 
+func (m *EventFilter) GetKernelLoadEvents() []*KernelLoadEventFilter {
+	if m != nil {
+		return m.KernelLoadEvents
+	}
+	return nil
+}
+
+func (m *EventFilter) GetPrivilegeEvents() []*PrivilegeEventFilter {
+	if m != nil {
+		return m.PrivilegeEvents
+	}
+	return nil
+}
+
+func (m *EventFilter) GetMemoryEvents() []*MemoryEventFilter {
+	if m != nil {
+		return m.MemoryEvents
+	}
+	return nil
+}
+
 func (m *EventFilter) GetContainerEvents() []*ContainerEventFilter {
 	if m != nil {
 		return m.ContainerEvents
@@ -263,6 +294,20 @@ func (m *EventFilter) GetContainerEvents() []*ContainerEventFilter {
 	return nil
 }
 
+func (m *EventFilter) GetAlertEvents() []*AlertEventFilter {
+	if m != nil {
+		return m.AlertEvents
+	}
+	return nil
+}
+
+func (m *EventFilter) GetMetricsEvents() []*SensorMetricsEventFilter {
+	if m != nil {
+		return m.MetricsEvents
+	}
+	return nil
+}
+
 func (m *EventFilter) GetChargenEvents() []*ChargenEventFilter {
 	if m != nil {
 		return m.ChargenEvents
@@ -282,12 +327,20 @@ func (m *EventFilter) GetTickerEvents() []*TickerEventFilter {
 // "ANDed" to specify a matching event.
 type SyscallEventFilter struct {
 	// Required; type of system call event (entry or exit)
-	Type             SyscallEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.SyscallEventType" json:"type,omitempty"`
-	FilterExpression *Expression      `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+	Type SyscallEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.SyscallEventType" json:"type,omitempty"`
+	// Optional; name of the system call from
+	// arch/x86/entry/syscalls/syscall_64.tbl (e.g. "ptrace"). This
+	// is translated into a filter on the system call number.
+	Name string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	// Optional; indices (0 through 5) of the system call arguments
+	// to capture for enter events. If empty, all arguments are
+	// captured.
+	CaptureArgs      []uint32    `protobuf:"varint,4,rep,packed,name=capture_args,json=captureArgs" json:"capture_args,omitempty"`
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
 	// Required; system call number from
 	// arch/x86/entry/syscalls/syscall_64.tbl
 	Id *google_protobuf1.Int64Value `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
-	// These don't work yet
+	// Optional; precise value of a particular system call argument
 	Arg0 *google_protobuf1.UInt64Value `protobuf:"bytes,10,opt,name=arg0" json:"arg0,omitempty"`
 	Arg1 *google_protobuf1.UInt64Value `protobuf:"bytes,11,opt,name=arg1" json:"arg1,omitempty"`
 	Arg2 *google_protobuf1.UInt64Value `protobuf:"bytes,12,opt,name=arg2" json:"arg2,omitempty"`
@@ -310,6 +363,20 @@ func (m *SyscallEventFilter) GetType() SyscallEventType {
 	return SyscallEventType_SYSCALL_EVENT_TYPE_UNKNOWN
 }
 
+func (m *SyscallEventFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SyscallEventFilter) GetCaptureArgs() []uint32 {
+	if m != nil {
+		return m.CaptureArgs
+	}
+	return nil
+}
+
 func (m *SyscallEventFilter) GetFilterExpression() *Expression {
 	if m != nil {
 		return m.FilterExpression
@@ -435,6 +502,15 @@ type FileEventFilter struct {
 	// Required; the file event type to match
 	Type             FileEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.FileEventType" json:"type,omitempty"`
 	FilterExpression *Expression   `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+	// Optional; only match files whose names match at least one of
+	// these patterns (i.e. "/etc/*" or "*/.ssh/*"). A pattern may begin
+	// and end with "*" to match any prefix or suffix of the name. For
+	// rename events, the original filename is matched. Filenames are
+	// matched as given to the system call, so they may be relative, and
+	// for processes in containers they are relative to the container's
+	// root. The patterns are combined with filter_expression and
+	// matched in the kernel.
+	PathPatterns []string `protobuf:"bytes,101,rep,name=path_patterns,json=pathPatterns" json:"path_patterns,omitempty"`
 	// Optional; require exact match on the filename being acted upon
 	Filename *google_protobuf1.StringValue `protobuf:"bytes,10,opt,name=filename" json:"filename,omitempty"`
 	// Optional; require pattern match on the filename being acted upon
@@ -466,6 +542,13 @@ func (m *FileEventFilter) GetFilterExpression() *Expression {
 	return nil
 }
 
+func (m *FileEventFilter) GetPathPatterns() []string {
+	if m != nil {
+		return m.PathPatterns
+	}
+	return nil
+}
+
 func (m *FileEventFilter) GetFilename() *google_protobuf1.StringValue {
 	if m != nil {
 		return m.Filename
@@ -582,6 +665,139 @@ func (m *NetworkEventFilter) GetFilterExpression() *Expression {
 	return nil
 }
 
+// The KernelLoadEventFilter specifies which kernel module and BPF program
+// load events to include in the Subscription. The included filter can be
+// used to specify precisely which events should be included.
+type KernelLoadEventFilter struct {
+	// Required; the kernel load event type to match
+	Type KernelLoadEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.KernelLoadEventType" json:"type,omitempty"`
+	// Optional; a filter to apply to events. Only events for which the
+	// evaluation of the filter expression is true will be returned.
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+}
+
+func (m *KernelLoadEventFilter) Reset()                    { *m = KernelLoadEventFilter{} }
+func (m *KernelLoadEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*KernelLoadEventFilter) ProtoMessage()               {}
+func (*KernelLoadEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
+
+func (m *KernelLoadEventFilter) GetType() KernelLoadEventType {
+	if m != nil {
+		return m.Type
+	}
+	return KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_UNKNOWN
+}
+
+func (m *KernelLoadEventFilter) GetFilterExpression() *Expression {
+	if m != nil {
+		return m.FilterExpression
+	}
+	return nil
+}
+
+// The PrivilegeEventFilter specifies which privilege change events to
+// include in the Subscription. The included filter can be used to specify
+// precisely which events should be included.
+type PrivilegeEventFilter struct {
+	// Required; the privilege event type to match
+	Type PrivilegeEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.PrivilegeEventType" json:"type,omitempty"`
+	// Optional; a filter to apply to events. Only events for which the
+	// evaluation of the filter expression is true will be returned.
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+}
+
+func (m *PrivilegeEventFilter) Reset()                    { *m = PrivilegeEventFilter{} }
+func (m *PrivilegeEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*PrivilegeEventFilter) ProtoMessage()               {}
+func (*PrivilegeEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
+
+func (m *PrivilegeEventFilter) GetType() PrivilegeEventType {
+	if m != nil {
+		return m.Type
+	}
+	return PrivilegeEventType_PRIVILEGE_EVENT_TYPE_UNKNOWN
+}
+
+func (m *PrivilegeEventFilter) GetFilterExpression() *Expression {
+	if m != nil {
+		return m.FilterExpression
+	}
+	return nil
+}
+
+// The MemoryEventFilter specifies which memory protection and injection
+// events to include in the Subscription. The included filter can be used
+// to specify precisely which events should be included.
+type MemoryEventFilter struct {
+	// Required; the memory event type to match
+	Type MemoryEventType `protobuf:"varint,1,opt,name=type,enum=capsule8.api.v0.MemoryEventType" json:"type,omitempty"`
+	// Optional; a filter to apply to events. Only events for which the
+	// evaluation of the filter expression is true will be returned.
+	FilterExpression *Expression `protobuf:"bytes,100,opt,name=filter_expression,json=filterExpression" json:"filter_expression,omitempty"`
+}
+
+func (m *MemoryEventFilter) Reset()                    { *m = MemoryEventFilter{} }
+func (m *MemoryEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*MemoryEventFilter) ProtoMessage()               {}
+func (*MemoryEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
+
+func (m *MemoryEventFilter) GetType() MemoryEventType {
+	if m != nil {
+		return m.Type
+	}
+	return MemoryEventType_MEMORY_EVENT_TYPE_UNKNOWN
+}
+
+func (m *MemoryEventFilter) GetFilterExpression() *Expression {
+	if m != nil {
+		return m.FilterExpression
+	}
+	return nil
+}
+
+// The AlertEventFilter specifies which of the Sensor's built-in detection
+// rules to run for the Subscription. The Sensor subscribes internally to the
+// events each rule needs; those events are not returned unless they are
+// requested separately.
+type AlertEventFilter struct {
+	// Optional; the name of the rule to run. If empty, all rules are
+	// run.
+	Rule string `protobuf:"bytes,1,opt,name=rule" json:"rule,omitempty"`
+}
+
+func (m *AlertEventFilter) Reset()                    { *m = AlertEventFilter{} }
+func (m *AlertEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*AlertEventFilter) ProtoMessage()               {}
+func (*AlertEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{11} }
+
+func (m *AlertEventFilter) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+// The SensorMetricsEventFilter requests periodic reports of the Sensor's own
+// resource usage.
+type SensorMetricsEventFilter struct {
+	// Optional; the interval at which reports are generated (in
+	// nanoseconds). If zero, the Sensor's measurement interval is used.
+	// Intervals shorter than 100ms are raised to 100ms.
+	Interval int64 `protobuf:"varint,1,opt,name=interval" json:"interval,omitempty"`
+}
+
+func (m *SensorMetricsEventFilter) Reset()                    { *m = SensorMetricsEventFilter{} }
+func (m *SensorMetricsEventFilter) String() string            { return proto.CompactTextString(m) }
+func (*SensorMetricsEventFilter) ProtoMessage()               {}
+func (*SensorMetricsEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{12} }
+
+func (m *SensorMetricsEventFilter) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
 // The ContainerEventFilter specifies which container lifecycle events
 // to include in the Subscription. In order to restrict them to
 // specific containers, use the ContainerFilter.
@@ -598,7 +814,7 @@ type ContainerEventFilter struct {
 func (m *ContainerEventFilter) Reset()                    { *m = ContainerEventFilter{} }
 func (m *ContainerEventFilter) String() string            { return proto.CompactTextString(m) }
 func (*ContainerEventFilter) ProtoMessage()               {}
-func (*ContainerEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
+func (*ContainerEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{13} }
 
 func (m *ContainerEventFilter) GetType() ContainerEventType {
 	if m != nil {
@@ -626,12 +842,15 @@ func (m *ContainerEventFilter) GetFilterExpression() *Expression {
 type ChargenEventFilter struct {
 	// Required; the length of character sequence strings to generate
 	Length uint64 `protobuf:"varint,1,opt,name=length" json:"length,omitempty"`
+	// Optional; the number of events to generate per second. If zero,
+	// events are generated as quickly as possible.
+	Rate uint64 `protobuf:"varint,2,opt,name=rate" json:"rate,omitempty"`
 }
 
 func (m *ChargenEventFilter) Reset()                    { *m = ChargenEventFilter{} }
 func (m *ChargenEventFilter) String() string            { return proto.CompactTextString(m) }
 func (*ChargenEventFilter) ProtoMessage()               {}
-func (*ChargenEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
+func (*ChargenEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{14} }
 
 func (m *ChargenEventFilter) GetLength() uint64 {
 	if m != nil {
@@ -640,6 +859,13 @@ func (m *ChargenEventFilter) GetLength() uint64 {
 	return 0
 }
 
+func (m *ChargenEventFilter) GetRate() uint64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
 // The TickerEventFilter configures a ticker stream generator and
 // includes events from it in the Subscription.
 type TickerEventFilter struct {
@@ -650,7 +876,7 @@ type TickerEventFilter struct {
 func (m *TickerEventFilter) Reset()                    { *m = TickerEventFilter{} }
 func (m *TickerEventFilter) String() string            { return proto.CompactTextString(m) }
 func (*TickerEventFilter) ProtoMessage()               {}
-func (*TickerEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
+func (*TickerEventFilter) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{15} }
 
 func (m *TickerEventFilter) GetInterval() int64 {
 	if m != nil {
@@ -665,12 +891,15 @@ func (m *TickerEventFilter) GetInterval() int64 {
 type Modifier struct {
 	Throttle *ThrottleModifier `protobuf:"bytes,1,opt,name=throttle" json:"throttle,omitempty"`
 	Limit    *LimitModifier    `protobuf:"bytes,2,opt,name=limit" json:"limit,omitempty"`
+	Sample   *SampleModifier   `protobuf:"bytes,3,opt,name=sample" json:"sample,omitempty"`
+	Batch    *BatchModifier    `protobuf:"bytes,4,opt,name=batch" json:"batch,omitempty"`
+	Coalesce *CoalesceModifier `protobuf:"bytes,5,opt,name=coalesce" json:"coalesce,omitempty"`
 }
 
 func (m *Modifier) Reset()                    { *m = Modifier{} }
 func (m *Modifier) String() string            { return proto.CompactTextString(m) }
 func (*Modifier) ProtoMessage()               {}
-func (*Modifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{11} }
+func (*Modifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{16} }
 
 func (m *Modifier) GetThrottle() *ThrottleModifier {
 	if m != nil {
@@ -686,6 +915,27 @@ func (m *Modifier) GetLimit() *LimitModifier {
 	return nil
 }
 
+func (m *Modifier) GetSample() *SampleModifier {
+	if m != nil {
+		return m.Sample
+	}
+	return nil
+}
+
+func (m *Modifier) GetBatch() *BatchModifier {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (m *Modifier) GetCoalesce() *CoalesceModifier {
+	if m != nil {
+		return m.Coalesce
+	}
+	return nil
+}
+
 // The ThrottleModifier modulates events sent by the Sensor to one per
 // time interval specified.
 type ThrottleModifier struct {
@@ -693,12 +943,17 @@ type ThrottleModifier struct {
 	Interval int64 `protobuf:"varint,1,opt,name=interval" json:"interval,omitempty"`
 	// Required; the intreval type (milliseconds, seconds, etc.)
 	IntervalType ThrottleModifier_IntervalType `protobuf:"varint,2,opt,name=interval_type,json=intervalType,enum=capsule8.api.v0.ThrottleModifier_IntervalType" json:"interval_type,omitempty"`
+	// Optional; the name of an Event field to throttle on (one of
+	// "container_id", "image_id", "process_id", or "sensor_id"). If
+	// set, at most one event per distinct value of the field is sent
+	// per interval and the rest are dropped rather than delayed.
+	Key string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty"`
 }
 
 func (m *ThrottleModifier) Reset()                    { *m = ThrottleModifier{} }
 func (m *ThrottleModifier) String() string            { return proto.CompactTextString(m) }
 func (*ThrottleModifier) ProtoMessage()               {}
-func (*ThrottleModifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{12} }
+func (*ThrottleModifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{17} }
 
 func (m *ThrottleModifier) GetInterval() int64 {
 	if m != nil {
@@ -714,6 +969,13 @@ func (m *ThrottleModifier) GetIntervalType() ThrottleModifier_IntervalType {
 	return ThrottleModifier_MILLISECOND
 }
 
+func (m *ThrottleModifier) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
 // The LimitModifier cancels the subscription on each Sensor after the
 // specified number of events. The entire Subscription may return more
 // events that this depending on how many active Sensors there are.
@@ -725,7 +987,7 @@ type LimitModifier struct {
 func (m *LimitModifier) Reset()                    { *m = LimitModifier{} }
 func (m *LimitModifier) String() string            { return proto.CompactTextString(m) }
 func (*LimitModifier) ProtoMessage()               {}
-func (*LimitModifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{13} }
+func (*LimitModifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{18} }
 
 func (m *LimitModifier) GetLimit() int64 {
 	if m != nil {
@@ -734,6 +996,90 @@ func (m *LimitModifier) GetLimit() int64 {
 	return 0
 }
 
+// The SampleModifier randomly drops events so that, on average, only the
+// specified fraction of events is sent by the Sensor.
+type SampleModifier struct {
+	// Required; the fraction of events to keep, greater than 0 and
+	// at most 1
+	Rate float64 `protobuf:"fixed64,1,opt,name=rate" json:"rate,omitempty"`
+}
+
+func (m *SampleModifier) Reset()                    { *m = SampleModifier{} }
+func (m *SampleModifier) String() string            { return proto.CompactTextString(m) }
+func (*SampleModifier) ProtoMessage()               {}
+func (*SampleModifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{19} }
+
+func (m *SampleModifier) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+// The CoalesceModifier merges identical events, i.e. events that differ
+// only in their id, sequence number, timestamp and CPU, that occur within a
+// time window into a single event. The merged event is the first of them,
+// with coalesced_count and last_sensor_monotime_nanos set. Each event is
+// held by the Sensor for the window before it is sent.
+type CoalesceModifier struct {
+	// Required; the length of the window in milliseconds, starting
+	// with the first of a set of identical events
+	WindowMs int64 `protobuf:"varint,1,opt,name=window_ms,json=windowMs" json:"window_ms,omitempty"`
+}
+
+func (m *CoalesceModifier) Reset()                    { *m = CoalesceModifier{} }
+func (m *CoalesceModifier) String() string            { return proto.CompactTextString(m) }
+func (*CoalesceModifier) ProtoMessage()               {}
+func (*CoalesceModifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{20} }
+
+func (m *CoalesceModifier) GetWindowMs() int64 {
+	if m != nil {
+		return m.WindowMs
+	}
+	return 0
+}
+
+// The BatchModifier groups events sent by the Sensor into batches. A batch
+// is sent as soon as any one of the specified limits is reached. Limits
+// that are zero are not used; if all are zero, events are sent one at a
+// time.
+type BatchModifier struct {
+	// Optional; the maximum number of events in a batch
+	MaxEvents int64 `protobuf:"varint,1,opt,name=max_events,json=maxEvents" json:"max_events,omitempty"`
+	// Optional; the maximum encoded size of a batch in bytes. A single
+	// event larger than this is sent in a batch by itself.
+	MaxBytes int64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes" json:"max_bytes,omitempty"`
+	// Optional; the maximum number of milliseconds an event may wait
+	// in a batch before the batch is sent
+	MaxLatencyMs int64 `protobuf:"varint,3,opt,name=max_latency_ms,json=maxLatencyMs" json:"max_latency_ms,omitempty"`
+}
+
+func (m *BatchModifier) Reset()                    { *m = BatchModifier{} }
+func (m *BatchModifier) String() string            { return proto.CompactTextString(m) }
+func (*BatchModifier) ProtoMessage()               {}
+func (*BatchModifier) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{21} }
+
+func (m *BatchModifier) GetMaxEvents() int64 {
+	if m != nil {
+		return m.MaxEvents
+	}
+	return 0
+}
+
+func (m *BatchModifier) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *BatchModifier) GetMaxLatencyMs() int64 {
+	if m != nil {
+		return m.MaxLatencyMs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Subscription)(nil), "capsule8.api.v0.Subscription")
 	proto.RegisterType((*ContainerFilter)(nil), "capsule8.api.v0.ContainerFilter")
@@ -743,12 +1089,20 @@ func init() {
 	proto.RegisterType((*FileEventFilter)(nil), "capsule8.api.v0.FileEventFilter")
 	proto.RegisterType((*KernelFunctionCallFilter)(nil), "capsule8.api.v0.KernelFunctionCallFilter")
 	proto.RegisterType((*NetworkEventFilter)(nil), "capsule8.api.v0.NetworkEventFilter")
+	proto.RegisterType((*KernelLoadEventFilter)(nil), "capsule8.api.v0.KernelLoadEventFilter")
+	proto.RegisterType((*PrivilegeEventFilter)(nil), "capsule8.api.v0.PrivilegeEventFilter")
+	proto.RegisterType((*MemoryEventFilter)(nil), "capsule8.api.v0.MemoryEventFilter")
+	proto.RegisterType((*AlertEventFilter)(nil), "capsule8.api.v0.AlertEventFilter")
+	proto.RegisterType((*SensorMetricsEventFilter)(nil), "capsule8.api.v0.SensorMetricsEventFilter")
 	proto.RegisterType((*ContainerEventFilter)(nil), "capsule8.api.v0.ContainerEventFilter")
 	proto.RegisterType((*ChargenEventFilter)(nil), "capsule8.api.v0.ChargenEventFilter")
 	proto.RegisterType((*TickerEventFilter)(nil), "capsule8.api.v0.TickerEventFilter")
 	proto.RegisterType((*Modifier)(nil), "capsule8.api.v0.Modifier")
 	proto.RegisterType((*ThrottleModifier)(nil), "capsule8.api.v0.ThrottleModifier")
 	proto.RegisterType((*LimitModifier)(nil), "capsule8.api.v0.LimitModifier")
+	proto.RegisterType((*SampleModifier)(nil), "capsule8.api.v0.SampleModifier")
+	proto.RegisterType((*CoalesceModifier)(nil), "capsule8.api.v0.CoalesceModifier")
+	proto.RegisterType((*BatchModifier)(nil), "capsule8.api.v0.BatchModifier")
 	proto.RegisterEnum("capsule8.api.v0.ContainerEventView", ContainerEventView_name, ContainerEventView_value)
 	proto.RegisterEnum("capsule8.api.v0.ThrottleModifier_IntervalType", ThrottleModifier_IntervalType_name, ThrottleModifier_IntervalType_value)
 }
@@ -756,81 +1110,106 @@ func init() {
 func init() { proto.RegisterFile("capsule8/api/v0/subscription.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 1210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x8e, 0x2e, 0x0e, 0xa4, 0xa3, 0x6b, 0xe6, 0xcf, 0x5f, 0xa8, 0x4e, 0x90, 0xba, 0x0c, 0x0c,
-	0x24, 0x6d, 0x2a, 0x39, 0xb2, 0xdd, 0x18, 0x45, 0x6f, 0xb6, 0x62, 0x25, 0x6a, 0x6c, 0xc7, 0xa0,
-	0x6c, 0x2f, 0xba, 0x11, 0x68, 0x72, 0x24, 0x0f, 0x44, 0x91, 0xc4, 0xcc, 0x48, 0xb6, 0x56, 0x7d,
-	0x8a, 0x2e, 0xdb, 0xc7, 0xe9, 0x03, 0x14, 0x05, 0xfa, 0x02, 0xdd, 0xf5, 0x25, 0x8a, 0xb9, 0x50,
-	0xa2, 0xc4, 0xc8, 0xd2, 0x22, 0xde, 0xcd, 0x9c, 0xf9, 0xbe, 0x8f, 0xe7, 0x32, 0x73, 0x66, 0x08,
-	0x86, 0x6d, 0x05, 0x6c, 0xe8, 0xe2, 0xbd, 0x9a, 0x15, 0x90, 0xda, 0x68, 0xab, 0xc6, 0x86, 0x97,
-	0xcc, 0xa6, 0x24, 0xe0, 0xc4, 0xf7, 0xaa, 0x01, 0xf5, 0xb9, 0x8f, 0x4a, 0x21, 0xa6, 0x6a, 0x05,
-	0xa4, 0x3a, 0xda, 0x5a, 0x7f, 0x34, 0x4f, 0xc2, 0x23, 0xec, 0x71, 0x85, 0x5e, 0xdf, 0x88, 0x2d,
-	0xde, 0x04, 0x14, 0x33, 0x36, 0xd1, 0x5b, 0x7f, 0xd2, 0xf3, 0xfd, 0x9e, 0x8b, 0x6b, 0x72, 0x76,
-	0x39, 0xec, 0xd6, 0xae, 0xa9, 0x15, 0x04, 0x98, 0x32, 0xb5, 0x6e, 0xfc, 0x9d, 0x84, 0x7c, 0x3b,
-	0xe2, 0x06, 0xfa, 0x01, 0xf2, 0xf2, 0x0b, 0x9d, 0x2e, 0x71, 0x39, 0xa6, 0x95, 0xc4, 0x46, 0xe2,
-	0x59, 0xae, 0xfe, 0xb8, 0x3a, 0xe7, 0x57, 0xf5, 0x50, 0x80, 0x9a, 0x12, 0x63, 0xe6, 0xf0, 0x74,
-	0x82, 0xde, 0x41, 0xd9, 0xf6, 0x3d, 0x6e, 0x11, 0x0f, 0xd3, 0x50, 0x24, 0x29, 0x45, 0x36, 0x62,
-	0x22, 0x8d, 0x10, 0xa8, 0x85, 0x4a, 0xf6, 0xac, 0x01, 0x1d, 0x40, 0x91, 0x11, 0xcf, 0xc6, 0x1d,
-	0x67, 0x48, 0x2d, 0xe1, 0x5f, 0x05, 0xa4, 0xd4, 0xa3, 0xaa, 0x8a, 0xab, 0x1a, 0xc6, 0x55, 0x6d,
-	0x79, 0xfc, 0xeb, 0x9d, 0x0b, 0xcb, 0x1d, 0x62, 0xb3, 0x20, 0x29, 0xaf, 0x35, 0x03, 0x7d, 0x0f,
-	0xf9, 0xae, 0x4f, 0xa7, 0x0a, 0xb9, 0xe5, 0x0a, 0xb9, 0xae, 0x4f, 0x27, 0xfc, 0x5d, 0xc8, 0x0c,
-	0x7c, 0x87, 0x74, 0x09, 0xa6, 0x95, 0x87, 0x92, 0xfb, 0x69, 0x2c, 0x90, 0x63, 0x0d, 0x30, 0x27,
-	0x50, 0xe3, 0x1a, 0x4a, 0x73, 0xe1, 0xa1, 0x32, 0xa4, 0x88, 0xc3, 0x2a, 0x89, 0x8d, 0xd4, 0xb3,
-	0xac, 0x29, 0x86, 0xe8, 0x21, 0xac, 0x79, 0xd6, 0x00, 0xb3, 0x4a, 0x52, 0xda, 0xd4, 0x04, 0x3d,
-	0x82, 0x2c, 0x19, 0x58, 0x3d, 0xdc, 0x11, 0xe8, 0x94, 0x5c, 0xc9, 0x48, 0x43, 0xcb, 0x61, 0xe8,
-	0x33, 0xc8, 0xa9, 0x45, 0x45, 0x4c, 0xcb, 0x65, 0x90, 0xa6, 0x13, 0x61, 0x31, 0xfe, 0x4d, 0x43,
-	0x2e, 0x52, 0x1d, 0xf4, 0x13, 0x14, 0xd9, 0x98, 0xd9, 0x96, 0xeb, 0x76, 0x64, 0x9d, 0x94, 0x03,
-	0xb9, 0xfa, 0xd3, 0x58, 0x14, 0x6d, 0x05, 0x8b, 0x96, 0xb6, 0xc0, 0x22, 0x36, 0x26, 0xb4, 0x02,
-	0xea, 0xdb, 0x98, 0xb1, 0x50, 0x2b, 0xb9, 0x40, 0xeb, 0x54, 0xc1, 0x66, 0xb4, 0x82, 0x88, 0x8d,
-	0xa1, 0x7d, 0xc8, 0x75, 0x89, 0x8b, 0x43, 0xa1, 0x94, 0x14, 0x8a, 0xef, 0x91, 0x26, 0x71, 0x71,
-	0x54, 0x05, 0xba, 0xa1, 0x81, 0xa1, 0x13, 0x28, 0xf4, 0x31, 0xf5, 0xf0, 0x24, 0xb2, 0xb4, 0x14,
-	0x79, 0x1e, 0x13, 0x79, 0x27, 0x51, 0xcd, 0xa1, 0x67, 0x8b, 0x92, 0x36, 0x2c, 0xd7, 0xd5, 0x6a,
-	0x79, 0xc5, 0x9f, 0x86, 0xe7, 0x61, 0x7e, 0xed, 0xd3, 0x7e, 0x28, 0xb8, 0xb6, 0x20, 0xbc, 0x13,
-	0x05, 0x9b, 0x09, 0xcf, 0x8b, 0xd8, 0x18, 0x3a, 0x8d, 0x9e, 0x03, 0xad, 0x06, 0x52, 0x6d, 0x73,
-	0xf1, 0x39, 0x88, 0xea, 0x4d, 0x0f, 0xc3, 0xd4, 0x3b, 0xfb, 0xca, 0xa2, 0x3d, 0xec, 0x85, 0x7a,
-	0xce, 0x02, 0xef, 0x1a, 0x0a, 0x36, 0xe3, 0x9d, 0x1d, 0xb1, 0x31, 0xf4, 0x06, 0x0a, 0x9c, 0xd8,
-	0xfd, 0xa9, 0x6b, 0x58, 0x4a, 0x19, 0x31, 0xa9, 0x33, 0x89, 0x8a, 0x2a, 0xe5, 0xf9, 0xd4, 0xc4,
-	0x8c, 0xdf, 0xd2, 0x80, 0xe2, 0xfb, 0x06, 0xed, 0x42, 0x9a, 0x8f, 0x03, 0x2c, 0xdb, 0x47, 0xb1,
-	0xfe, 0xf9, 0xad, 0x5b, 0xed, 0x6c, 0x1c, 0x60, 0x53, 0xc2, 0xd1, 0x5b, 0x78, 0xa0, 0x5a, 0x46,
-	0x67, 0xda, 0xc9, 0x2a, 0x8e, 0x3e, 0xb0, 0xb1, 0x16, 0x34, 0x81, 0x98, 0x65, 0xc5, 0x9a, 0x5a,
-	0xd0, 0x97, 0x90, 0x24, 0x8e, 0x6e, 0x3c, 0xb7, 0x9e, 0xf5, 0x24, 0x71, 0xd0, 0x16, 0xa4, 0x2d,
-	0xda, 0xdb, 0xd2, 0xcd, 0xe5, 0x71, 0x0c, 0x7e, 0x1e, 0xc1, 0x4b, 0xa4, 0x66, 0xbc, 0xd4, 0xcd,
-	0x64, 0x39, 0xe3, 0xa5, 0x66, 0xd4, 0x2b, 0xf9, 0x15, 0x19, 0x75, 0xcd, 0xd8, 0xae, 0x14, 0x56,
-	0x64, 0x6c, 0x6b, 0xc6, 0x4e, 0xa5, 0xb8, 0x22, 0x63, 0x47, 0x33, 0x76, 0x2b, 0xa5, 0x15, 0x19,
-	0xbb, 0xe8, 0x2b, 0x48, 0x51, 0xcc, 0x75, 0x27, 0xbc, 0x35, 0xb3, 0x02, 0x67, 0xfc, 0x93, 0x04,
-	0x14, 0xef, 0x05, 0x4b, 0xf7, 0x47, 0x94, 0x72, 0x27, 0xfb, 0x63, 0x1f, 0x0a, 0xf8, 0x06, 0xdb,
-	0xe2, 0x86, 0xc2, 0xa2, 0x93, 0x2e, 0xac, 0x4b, 0x9b, 0x53, 0xe2, 0xf5, 0x54, 0x44, 0x79, 0x41,
-	0x69, 0x6a, 0x06, 0x3a, 0x85, 0xff, 0xcf, 0x48, 0x74, 0x02, 0x8b, 0x73, 0x4c, 0xbd, 0x85, 0x05,
-	0x8b, 0x4a, 0xfd, 0x2f, 0x2a, 0x75, 0xaa, 0x88, 0x68, 0x0f, 0xb2, 0xf8, 0x86, 0xf0, 0x8e, 0xed,
-	0x3b, 0x58, 0x17, 0xf1, 0x83, 0x19, 0xde, 0xae, 0x2b, 0x91, 0x8c, 0x40, 0x37, 0x7c, 0x07, 0x1b,
-	0xbf, 0xa7, 0xa0, 0x34, 0xd7, 0x29, 0x51, 0x7d, 0x26, 0xc7, 0x4f, 0x16, 0x77, 0xd6, 0x3b, 0x49,
-	0xf0, 0x1e, 0x64, 0x26, 0xb9, 0x85, 0x15, 0x12, 0x32, 0x41, 0xa3, 0x37, 0x50, 0x8e, 0xa5, 0x34,
-	0xb7, 0x82, 0x42, 0xa9, 0x3b, 0x97, 0xce, 0x06, 0x94, 0xfc, 0x00, 0x7b, 0x9d, 0xae, 0x6b, 0xf5,
-	0x58, 0x67, 0x60, 0xb1, 0xbe, 0xae, 0xf2, 0xad, 0x49, 0x2d, 0x08, 0x4e, 0x53, 0x50, 0x8e, 0x2d,
-	0xd6, 0x47, 0x87, 0x50, 0xb6, 0x29, 0xb6, 0x38, 0xee, 0x0c, 0x7c, 0x07, 0x2b, 0x95, 0xc2, 0x72,
-	0x95, 0xa2, 0x22, 0x1d, 0xfb, 0x0e, 0x16, 0x32, 0xc6, 0x5f, 0x49, 0xa8, 0x2c, 0xba, 0x85, 0xd0,
-	0x8f, 0x33, 0x95, 0x7a, 0xb1, 0xc2, 0xf5, 0x35, 0x5f, 0xb7, 0x4f, 0xe0, 0x3e, 0x1b, 0x0f, 0x2e,
-	0x7d, 0x57, 0xe6, 0x3a, 0x6b, 0xea, 0x19, 0xba, 0x80, 0xac, 0x45, 0x7b, 0xc3, 0x81, 0xec, 0xf1,
-	0x39, 0xd9, 0xe3, 0xf7, 0x56, 0xbe, 0x1d, 0xab, 0xfb, 0x21, 0xf5, 0xd0, 0xe3, 0x74, 0x6c, 0x4e,
-	0xa5, 0x3e, 0xde, 0x3e, 0x59, 0xff, 0x16, 0x8a, 0xb3, 0x9f, 0x11, 0xcf, 0xa4, 0x3e, 0x1e, 0xcb,
-	0x64, 0x64, 0x4d, 0x31, 0x14, 0xcf, 0xa4, 0x91, 0xc8, 0xaa, 0xec, 0xe7, 0x59, 0x53, 0x4d, 0xbe,
-	0x49, 0xee, 0x25, 0x8c, 0x5f, 0x13, 0x80, 0xe2, 0x77, 0xf1, 0xd2, 0xf6, 0x12, 0xa5, 0xdc, 0xc5,
-	0xee, 0x37, 0xfe, 0x4c, 0xc0, 0xc3, 0x0f, 0xdd, 0xea, 0xe8, 0xd5, 0x8c, 0x67, 0x4f, 0x97, 0x3c,
-	0x05, 0x22, 0xbe, 0xbd, 0x82, 0xf4, 0x88, 0xe0, 0x6b, 0x99, 0x82, 0xe5, 0xc4, 0x0b, 0x82, 0xaf,
-	0x4d, 0x49, 0xf8, 0x88, 0x41, 0xbd, 0x00, 0x14, 0x7f, 0x59, 0x88, 0xad, 0xe7, 0x62, 0xaf, 0xc7,
-	0xaf, 0x64, 0x4c, 0x69, 0x53, 0xcf, 0x8c, 0x1a, 0x3c, 0x88, 0x3d, 0x1e, 0xd0, 0x3a, 0x64, 0x88,
-	0xc7, 0x31, 0x1d, 0x59, 0xae, 0x84, 0xa7, 0xcc, 0xc9, 0xdc, 0xf8, 0x05, 0x32, 0xe1, 0x3b, 0x1a,
-	0x7d, 0x07, 0x19, 0x7e, 0x45, 0x7d, 0xce, 0x5d, 0xac, 0x7f, 0x41, 0xe2, 0x45, 0x3c, 0xd3, 0x80,
-	0xe9, 0xe3, 0x3b, 0xa4, 0xa0, 0x1d, 0x58, 0x73, 0xc9, 0x80, 0x70, 0xfd, 0x00, 0x88, 0xf7, 0xbe,
-	0x23, 0xb1, 0x3a, 0x21, 0x2a, 0xb0, 0xf1, 0x47, 0x02, 0xca, 0xf3, 0xa2, 0xb7, 0x79, 0x8c, 0xda,
-	0x50, 0x08, 0xc7, 0x1d, 0x59, 0x55, 0x55, 0x9c, 0xea, 0x52, 0x57, 0x45, 0xa7, 0x90, 0x34, 0x59,
-	0xe0, 0x3c, 0x89, 0xcc, 0x8c, 0x7d, 0xc8, 0x47, 0x57, 0x51, 0x09, 0x72, 0xc7, 0xad, 0xa3, 0xa3,
-	0x56, 0xfb, 0xb0, 0xf1, 0xfe, 0xe4, 0x75, 0xf9, 0x1e, 0x02, 0xb8, 0xaf, 0xc7, 0x09, 0x31, 0x3e,
-	0x6e, 0x9d, 0x9c, 0x9f, 0x1d, 0x96, 0x93, 0x28, 0x03, 0xe9, 0xb7, 0xef, 0xcf, 0xcd, 0x72, 0xca,
-	0xd8, 0x84, 0xc2, 0x4c, 0x80, 0xe2, 0x00, 0xa9, 0x7c, 0xa8, 0x08, 0xd4, 0xe4, 0x8b, 0xe7, 0x80,
-	0xe2, 0xbb, 0x06, 0x65, 0x61, 0xed, 0x60, 0xbf, 0xdd, 0x6a, 0x94, 0xef, 0x09, 0xc5, 0xe6, 0xf9,
-	0xd1, 0x51, 0x39, 0x71, 0xb0, 0xf9, 0xf3, 0xd3, 0x1e, 0xe1, 0x57, 0xc3, 0xcb, 0xaa, 0xed, 0x0f,
-	0x6a, 0x93, 0xdf, 0xce, 0xb9, 0xff, 0xcf, 0xcb, 0xfb, 0xb2, 0x15, 0x6e, 0xff, 0x17, 0x00, 0x00,
-	0xff, 0xff, 0x90, 0x3d, 0x18, 0x24, 0xeb, 0x0e, 0x00, 0x00,
+	// 1612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdb, 0x52, 0xdb, 0x48,
+	0x1a, 0x8e, 0x0f, 0x10, 0xfb, 0xf7, 0x31, 0xbd, 0x64, 0x4b, 0x0b, 0xd9, 0x84, 0x88, 0x24, 0x95,
+	0xec, 0xc1, 0x10, 0x03, 0x09, 0xb5, 0xb5, 0x87, 0x80, 0x03, 0x09, 0x1b, 0x4c, 0x28, 0x01, 0xb9,
+	0xd8, 0x1b, 0x57, 0x23, 0xb7, 0x8d, 0x0a, 0x9d, 0xb6, 0xbb, 0x0d, 0xf8, 0x39, 0xa6, 0xe6, 0x62,
+	0x6a, 0x9e, 0x61, 0x9e, 0x62, 0xde, 0x60, 0x2a, 0x55, 0x33, 0x0f, 0x30, 0x8f, 0x30, 0x0f, 0x30,
+	0xd5, 0xdd, 0x92, 0x2c, 0x59, 0x38, 0xf6, 0x45, 0xb8, 0xeb, 0xfe, 0xfb, 0xfb, 0x3e, 0xf5, 0x7f,
+	0x50, 0xf7, 0x2f, 0x81, 0x6e, 0x62, 0x9f, 0x0d, 0x6c, 0xb2, 0xb5, 0x8a, 0x7d, 0x6b, 0xf5, 0x72,
+	0x6d, 0x95, 0x0d, 0xce, 0x98, 0x49, 0x2d, 0x9f, 0x5b, 0x9e, 0xdb, 0xf0, 0xa9, 0xc7, 0x3d, 0x54,
+	0x0b, 0x31, 0x0d, 0xec, 0x5b, 0x8d, 0xcb, 0xb5, 0xc5, 0xa5, 0x71, 0x12, 0xb9, 0x24, 0x2e, 0x57,
+	0xe8, 0xc5, 0xe5, 0xd4, 0xe2, 0xb5, 0x4f, 0x09, 0x63, 0x91, 0xde, 0xe2, 0xc3, 0xbe, 0xe7, 0xf5,
+	0x6d, 0xb2, 0x2a, 0x67, 0x67, 0x83, 0xde, 0xea, 0x15, 0xc5, 0xbe, 0x4f, 0x28, 0x53, 0xeb, 0xfa,
+	0xcf, 0x59, 0x28, 0x1f, 0xc7, 0xb6, 0x81, 0xfe, 0x03, 0x65, 0xf9, 0x84, 0x4e, 0xcf, 0xb2, 0x39,
+	0xa1, 0x5a, 0x66, 0x39, 0xf3, 0xbc, 0xd4, 0x7c, 0xd0, 0x18, 0xdb, 0x57, 0x63, 0x57, 0x80, 0xf6,
+	0x24, 0xc6, 0x28, 0x91, 0xd1, 0x04, 0x7d, 0x80, 0xba, 0xe9, 0xb9, 0x1c, 0x5b, 0x2e, 0xa1, 0xa1,
+	0x48, 0x56, 0x8a, 0x2c, 0xa7, 0x44, 0x5a, 0x21, 0x30, 0x10, 0xaa, 0x99, 0x49, 0x03, 0xda, 0x81,
+	0x2a, 0xb3, 0x5c, 0x93, 0x74, 0xba, 0x03, 0x8a, 0xc5, 0xfe, 0x34, 0x90, 0x52, 0x4b, 0x0d, 0xe5,
+	0x57, 0x23, 0xf4, 0xab, 0xb1, 0xef, 0xf2, 0x57, 0x1b, 0x9f, 0xb0, 0x3d, 0x20, 0x46, 0x45, 0x52,
+	0xde, 0x06, 0x0c, 0xf4, 0x6f, 0x28, 0xf7, 0x3c, 0x3a, 0x52, 0x28, 0x4d, 0x57, 0x28, 0xf5, 0x3c,
+	0x1a, 0xf1, 0x37, 0xa1, 0xe0, 0x78, 0x5d, 0xab, 0x67, 0x11, 0xaa, 0x2d, 0x48, 0xee, 0x9f, 0x52,
+	0x8e, 0xb4, 0x03, 0x80, 0x11, 0x41, 0xf5, 0x2b, 0xa8, 0x8d, 0xb9, 0x87, 0xea, 0x90, 0xb3, 0xba,
+	0x4c, 0xcb, 0x2c, 0xe7, 0x9e, 0x17, 0x0d, 0x31, 0x44, 0x0b, 0x30, 0xe7, 0x62, 0x87, 0x30, 0x2d,
+	0x2b, 0x6d, 0x6a, 0x82, 0x96, 0xa0, 0x68, 0x39, 0xb8, 0x4f, 0x3a, 0x02, 0x9d, 0x93, 0x2b, 0x05,
+	0x69, 0xd8, 0xef, 0x32, 0xf4, 0x08, 0x4a, 0x6a, 0x51, 0x11, 0xf3, 0x72, 0x19, 0xa4, 0xe9, 0x50,
+	0x58, 0xf4, 0xdf, 0xee, 0x42, 0x29, 0x96, 0x1d, 0xf4, 0x5f, 0xa8, 0xb2, 0x21, 0x33, 0xb1, 0x6d,
+	0x77, 0x64, 0x9e, 0xd4, 0x06, 0x4a, 0xcd, 0x95, 0x94, 0x17, 0xc7, 0x0a, 0x16, 0x4f, 0x6d, 0x85,
+	0xc5, 0x6c, 0x4c, 0x68, 0xf9, 0xd4, 0x33, 0x09, 0x63, 0xa1, 0x56, 0x76, 0x82, 0xd6, 0x91, 0x82,
+	0x25, 0xb4, 0xfc, 0x98, 0x8d, 0xa1, 0x6d, 0x28, 0xf5, 0x2c, 0x9b, 0x84, 0x42, 0xb9, 0xe5, 0xdc,
+	0x8d, 0x35, 0xb2, 0x67, 0xd9, 0x24, 0xae, 0x02, 0xbd, 0xd0, 0xc0, 0xd0, 0x21, 0x54, 0x2e, 0x08,
+	0x75, 0x49, 0xe4, 0x59, 0x5e, 0x8a, 0xbc, 0x48, 0x89, 0x7c, 0x90, 0xa8, 0xbd, 0x81, 0x6b, 0x8a,
+	0x94, 0xb6, 0xb0, 0x6d, 0x07, 0x6a, 0x65, 0xc5, 0x1f, 0xb9, 0xe7, 0x12, 0x7e, 0xe5, 0xd1, 0x8b,
+	0x50, 0x70, 0x6e, 0x82, 0x7b, 0x87, 0x0a, 0x96, 0x70, 0xcf, 0x8d, 0xd9, 0x18, 0x3a, 0x01, 0x14,
+	0xec, 0xcd, 0xf6, 0x70, 0x37, 0xd4, 0x9b, 0x97, 0x7a, 0xcf, 0x26, 0x6c, 0xf0, 0xc0, 0xc3, 0xdd,
+	0xb8, 0x64, 0xfd, 0x22, 0x69, 0x66, 0xe8, 0x08, 0xea, 0x3e, 0xb5, 0x2e, 0x2d, 0x9b, 0xf4, 0xa3,
+	0xc8, 0xdd, 0x95, 0x9a, 0x4f, 0x6f, 0x48, 0x41, 0x00, 0x8c, 0x4b, 0xd6, 0xfc, 0x84, 0x95, 0xa1,
+	0x77, 0x50, 0x71, 0x88, 0xe3, 0xd1, 0x61, 0x28, 0x57, 0x90, 0x72, 0x7a, 0xba, 0xc6, 0x25, 0x2a,
+	0xae, 0x55, 0x76, 0x46, 0x26, 0xb9, 0xb5, 0xd1, 0x8b, 0x1f, 0x68, 0xc1, 0x84, 0xad, 0x45, 0x6f,
+	0x46, 0x62, 0x6b, 0x66, 0xc2, 0xca, 0xd0, 0x5b, 0x28, 0x63, 0x9b, 0x50, 0x1e, 0xaa, 0x35, 0xa5,
+	0xda, 0xe3, 0x94, 0xda, 0xb6, 0x00, 0x25, 0x0e, 0x24, 0x1c, 0x59, 0xc4, 0xbe, 0xaa, 0x0e, 0xe1,
+	0xd4, 0x32, 0xa3, 0x9a, 0x5d, 0x9f, 0x50, 0x25, 0xc7, 0xc4, 0x65, 0x1e, 0x6d, 0x2b, 0x70, 0x22,
+	0xb5, 0x4e, 0xcc, 0x26, 0xcb, 0xc4, 0x3c, 0xc7, 0xb4, 0x4f, 0xdc, 0x50, 0xb1, 0x3b, 0xa1, 0x4c,
+	0x5a, 0x0a, 0x96, 0xd0, 0x32, 0x63, 0x36, 0x19, 0x7e, 0x6e, 0x99, 0x17, 0xa3, 0x90, 0x91, 0x09,
+	0xe1, 0x3f, 0x91, 0xa8, 0x44, 0xf8, 0xf9, 0xc8, 0xc4, 0xf4, 0xcf, 0x79, 0x40, 0xe9, 0x17, 0x18,
+	0x6d, 0x42, 0x9e, 0x0f, 0x7d, 0x22, 0xcf, 0xf1, 0xea, 0x0d, 0xb1, 0x8b, 0x53, 0x4e, 0x86, 0x3e,
+	0x31, 0x24, 0x1c, 0x21, 0xc8, 0x8b, 0xf3, 0x45, 0xcb, 0x2d, 0x67, 0x9e, 0x17, 0x0d, 0x39, 0x46,
+	0x8f, 0xa1, 0x6c, 0x62, 0x9f, 0x0f, 0x28, 0xe9, 0x60, 0xda, 0x57, 0x2f, 0x5b, 0xc5, 0x28, 0x05,
+	0xb6, 0x6d, 0xda, 0x67, 0xe8, 0x3d, 0xdc, 0x53, 0x47, 0x7e, 0x67, 0x74, 0x13, 0x69, 0xdd, 0xe0,
+	0xc0, 0x4d, 0x5d, 0x21, 0x11, 0xc4, 0xa8, 0x2b, 0xd6, 0xc8, 0x82, 0xfe, 0x0a, 0x59, 0xab, 0xab,
+	0x65, 0xa7, 0x9f, 0xd5, 0x59, 0xab, 0x8b, 0xd6, 0x20, 0x8f, 0x69, 0x7f, 0x2d, 0xb8, 0x1c, 0x1e,
+	0xa4, 0xe0, 0xa7, 0x31, 0xbc, 0x44, 0x06, 0x8c, 0x97, 0x5a, 0x69, 0x46, 0xc6, 0xcb, 0x80, 0xd1,
+	0xd4, 0xca, 0x33, 0x32, 0x9a, 0x01, 0x63, 0x5d, 0xab, 0xcc, 0xc8, 0x58, 0x0f, 0x18, 0x1b, 0x5a,
+	0x75, 0x46, 0xc6, 0x46, 0xc0, 0xd8, 0xd4, 0x6a, 0x33, 0x32, 0x36, 0xd1, 0xdf, 0x21, 0x47, 0x09,
+	0xd7, 0x16, 0xa6, 0x47, 0x56, 0xe0, 0xf4, 0x5f, 0xb3, 0x80, 0xd2, 0x67, 0xf9, 0xd4, 0xb2, 0x8a,
+	0x53, 0x62, 0x65, 0xf5, 0xf5, 0xea, 0x63, 0x1b, 0x2a, 0xe4, 0x9a, 0x98, 0xa2, 0xc3, 0x20, 0xb2,
+	0x52, 0x27, 0xe5, 0xe5, 0x98, 0x53, 0xcb, 0xed, 0x2b, 0x8f, 0xca, 0x82, 0xb2, 0x17, 0x30, 0xd0,
+	0x11, 0xdc, 0x4f, 0x48, 0x74, 0x7c, 0xcc, 0x39, 0xa1, 0xae, 0x56, 0x99, 0x41, 0xea, 0x0f, 0x71,
+	0xa9, 0x23, 0x45, 0x44, 0x5b, 0x50, 0x24, 0xd7, 0x16, 0xef, 0x98, 0x5e, 0x97, 0x68, 0xd5, 0xc9,
+	0x11, 0x5e, 0x6f, 0x2a, 0x91, 0x82, 0x40, 0xb7, 0xbc, 0x2e, 0xd1, 0x7f, 0xcc, 0x41, 0x6d, 0xec,
+	0xa6, 0x43, 0xcd, 0x44, 0x8c, 0x1f, 0x4e, 0xbe, 0x19, 0x6f, 0x25, 0xc0, 0x2b, 0x50, 0xf1, 0x31,
+	0x3f, 0x0f, 0x83, 0xa2, 0x0e, 0xa6, 0xa2, 0x51, 0x16, 0xc6, 0xc0, 0x5f, 0x86, 0xb6, 0xa0, 0x10,
+	0x25, 0x00, 0x66, 0x88, 0x5a, 0x84, 0x46, 0xef, 0xa0, 0x9e, 0x8a, 0x7b, 0x69, 0x06, 0x85, 0x5a,
+	0x6f, 0x2c, 0xe6, 0x2d, 0xa8, 0x79, 0x3e, 0x71, 0x3b, 0x3d, 0x1b, 0xf7, 0x59, 0xc7, 0xc1, 0xec,
+	0x42, 0x2b, 0x4f, 0x8f, 0x7c, 0x45, 0x70, 0xf6, 0x04, 0xa5, 0x8d, 0xd9, 0x05, 0xda, 0x85, 0xba,
+	0x49, 0x09, 0xe6, 0xa4, 0xe3, 0x78, 0x5d, 0xa2, 0x54, 0x2a, 0xd3, 0x55, 0xaa, 0x8a, 0xd4, 0xf6,
+	0xba, 0x44, 0xc8, 0xe8, 0x9f, 0xb3, 0xa0, 0x4d, 0x6a, 0x35, 0xd0, 0x9b, 0x44, 0x3a, 0xff, 0x36,
+	0x43, 0x8f, 0x32, 0x9e, 0xdc, 0x3f, 0xc2, 0x3c, 0x1b, 0x3a, 0x67, 0x9e, 0x2d, 0x63, 0x5d, 0x34,
+	0x82, 0x19, 0xfa, 0x04, 0x45, 0x4c, 0xfb, 0x03, 0x47, 0xde, 0x1f, 0x25, 0x79, 0x7f, 0x6c, 0xcd,
+	0xdc, 0x02, 0x35, 0xb6, 0x43, 0xea, 0xae, 0xcb, 0xe9, 0xd0, 0x18, 0x49, 0x7d, 0xbd, 0x62, 0x5a,
+	0xfc, 0x27, 0x54, 0x93, 0x8f, 0x11, 0xbd, 0xf0, 0x05, 0x19, 0xca, 0x60, 0x14, 0x0d, 0x31, 0x14,
+	0xbd, 0xf0, 0xa5, 0x88, 0xaa, 0x3c, 0xf4, 0x8b, 0x86, 0x9a, 0xfc, 0x23, 0xbb, 0x95, 0xd1, 0xbf,
+	0xcd, 0x00, 0x4a, 0x37, 0x5c, 0x53, 0xcf, 0xa0, 0x38, 0xe5, 0x36, 0x5e, 0x11, 0xfd, 0xfb, 0x0c,
+	0xdc, 0xbf, 0xb1, 0x71, 0x43, 0x5b, 0x89, 0xad, 0x3d, 0x99, 0xd6, 0xee, 0xdd, 0xca, 0xee, 0xbe,
+	0xcb, 0xc0, 0xc2, 0x4d, 0x2d, 0x20, 0x7a, 0x9d, 0xd8, 0xdc, 0xca, 0x94, 0xbe, 0xf1, 0x56, 0xf6,
+	0xf6, 0x4d, 0x06, 0xee, 0xa5, 0xfa, 0x49, 0xb4, 0x91, 0xd8, 0xd8, 0xf2, 0x97, 0x3a, 0xd0, 0x5b,
+	0xd9, 0xd5, 0x33, 0xa8, 0x8f, 0xb7, 0x92, 0xa2, 0x11, 0xa2, 0x03, 0x9b, 0x04, 0x85, 0x2a, 0xc7,
+	0xfa, 0x2b, 0xd0, 0x26, 0xb5, 0x8a, 0x68, 0x11, 0x0a, 0x96, 0xcb, 0x09, 0xbd, 0xc4, 0xb6, 0xe4,
+	0xe4, 0x8c, 0x68, 0xae, 0xff, 0x94, 0x81, 0x85, 0x9b, 0x3a, 0xdf, 0xa9, 0x19, 0x49, 0x92, 0x62,
+	0xbe, 0xbf, 0x86, 0xfc, 0xa5, 0x45, 0xae, 0xb4, 0xec, 0x4c, 0xc4, 0x4f, 0x16, 0xb9, 0x32, 0x24,
+	0xe1, 0x2b, 0x06, 0xed, 0x0d, 0xa0, 0x74, 0x97, 0x2b, 0x8e, 0x2a, 0x9b, 0xb8, 0x7d, 0x7e, 0x2e,
+	0x7d, 0xca, 0x1b, 0xc1, 0x4c, 0x86, 0x13, 0x73, 0xf5, 0x8e, 0xe7, 0x0d, 0x39, 0xd6, 0x57, 0xe1,
+	0x5e, 0xaa, 0xb9, 0xfd, 0x62, 0x1c, 0x7f, 0xc8, 0x42, 0x21, 0xfc, 0xe2, 0x46, 0xff, 0x82, 0x02,
+	0x3f, 0xa7, 0x1e, 0xe7, 0x41, 0x92, 0x6e, 0xfa, 0x40, 0x38, 0x09, 0x00, 0xa3, 0xcf, 0xf4, 0x90,
+	0x82, 0x36, 0x60, 0xce, 0xb6, 0x1c, 0x8b, 0x07, 0xad, 0x66, 0xfa, 0x96, 0x3d, 0x10, 0xab, 0x11,
+	0x51, 0x81, 0xd1, 0x6b, 0x98, 0x67, 0xd8, 0xf1, 0x6d, 0xd5, 0x20, 0x97, 0x9a, 0x8f, 0xd2, 0x7d,
+	0xb5, 0x5c, 0x8e, 0x78, 0x01, 0x5c, 0x3c, 0xee, 0x0c, 0x73, 0xf3, 0x5c, 0xcb, 0x4f, 0x78, 0xdc,
+	0x8e, 0x58, 0x1d, 0x3d, 0x4e, 0x82, 0x85, 0x8f, 0xa6, 0x87, 0x6d, 0xc2, 0x4c, 0xa2, 0xcd, 0x4d,
+	0xf0, 0xb1, 0x15, 0x00, 0x46, 0x3e, 0x86, 0x14, 0xfd, 0x97, 0x0c, 0xd4, 0xc7, 0x43, 0xf0, 0xa5,
+	0x00, 0xa3, 0x63, 0xa8, 0x84, 0xe3, 0x8e, 0x2c, 0x4c, 0x55, 0x5f, 0x8d, 0xa9, 0x81, 0x6d, 0xec,
+	0x07, 0x34, 0x59, 0xa3, 0x65, 0x2b, 0x36, 0x0b, 0x4f, 0xfc, 0x5c, 0x74, 0xe2, 0xeb, 0xdb, 0x50,
+	0x8e, 0xe3, 0x51, 0x0d, 0x4a, 0xed, 0xfd, 0x83, 0x83, 0xfd, 0xe3, 0xdd, 0xd6, 0xc7, 0xc3, 0xb7,
+	0xf5, 0x3b, 0x08, 0x60, 0x3e, 0x18, 0x67, 0xc4, 0xb8, 0xbd, 0x7f, 0x78, 0x7a, 0xb2, 0x5b, 0xcf,
+	0xa2, 0x02, 0xe4, 0xdf, 0x7f, 0x3c, 0x35, 0xea, 0x39, 0xfd, 0x29, 0x54, 0x12, 0x09, 0x12, 0xb7,
+	0x88, 0xca, 0xa7, 0xf2, 0x49, 0x4d, 0xf4, 0x27, 0x50, 0x4d, 0x26, 0x24, 0x2a, 0x44, 0x01, 0xcb,
+	0x44, 0x85, 0x58, 0x1f, 0x8f, 0xa2, 0xf8, 0x17, 0x73, 0x65, 0xb9, 0x5d, 0xef, 0xaa, 0xe3, 0xb0,
+	0x30, 0x4e, 0xca, 0xd0, 0x66, 0xfa, 0xff, 0xa1, 0x92, 0xc8, 0x17, 0xfa, 0x33, 0x80, 0x83, 0xaf,
+	0x47, 0xff, 0x59, 0x04, 0xbc, 0xe8, 0xe0, 0xeb, 0xe0, 0x63, 0x6f, 0x09, 0xc4, 0xa4, 0x73, 0x36,
+	0xe4, 0xf2, 0x97, 0x8f, 0x14, 0x73, 0xf0, 0xf5, 0x8e, 0x98, 0xa3, 0x27, 0x50, 0x15, 0x8b, 0x36,
+	0xe6, 0xc4, 0x35, 0x87, 0xe2, 0x71, 0x39, 0x89, 0x28, 0x3b, 0xf8, 0xfa, 0x40, 0x19, 0xdb, 0xec,
+	0x2f, 0x2f, 0x00, 0xa5, 0x5f, 0x6a, 0x54, 0x84, 0xb9, 0x9d, 0xed, 0xe3, 0xfd, 0x56, 0xfd, 0x8e,
+	0x88, 0xcd, 0xde, 0xe9, 0xc1, 0x41, 0x3d, 0xb3, 0xf3, 0xf4, 0x7f, 0x2b, 0x7d, 0x8b, 0x9f, 0x0f,
+	0xce, 0x1a, 0xa6, 0xe7, 0xac, 0x46, 0xbf, 0x0a, 0xc7, 0xfe, 0x19, 0x9e, 0xcd, 0xcb, 0xce, 0x66,
+	0xfd, 0xf7, 0x01, 0x00, 0x94, 0x76, 0xea, 0x40, 0x9f, 0x14, 0x00, 0x00,
 }
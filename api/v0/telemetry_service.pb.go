@@ -41,6 +41,14 @@ func (m *GetEventsRequest) GetSubscription() *Subscription {
 type GetEventsResponse struct {
 	// Can publish one or more message(s) at a time
 	Events []*TelemetryEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	// The number of events dropped since the previous response because
+	// the client was not keeping up. Only reported by transports that
+	// drop events rather than applying backpressure.
+	DroppedEvents uint64 `protobuf:"varint,2,opt,name=dropped_events,json=droppedEvents" json:"dropped_events,omitempty"`
+	// The status of the subscription. This is set in the first response
+	// sent for a subscription to acknowledge that it was accepted, and
+	// in the last response sent before the subscription ends.
+	Status *SubscriptionStatus `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
 }
 
 func (m *GetEventsResponse) Reset()                    { *m = GetEventsResponse{} }
@@ -55,6 +63,148 @@ func (m *GetEventsResponse) GetEvents() []*TelemetryEvent {
 	return nil
 }
 
+func (m *GetEventsResponse) GetDroppedEvents() uint64 {
+	if m != nil {
+		return m.DroppedEvents
+	}
+	return 0
+}
+
+func (m *GetEventsResponse) GetStatus() *SubscriptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+// The status of a telemetry subscription
+type SubscriptionStatus struct {
+	// The event sources requested by the subscription
+	Sources []*EventSourceStatus `protobuf:"bytes,1,rep,name=sources" json:"sources,omitempty"`
+	// The number of events sent for the subscription
+	Events uint64 `protobuf:"varint,2,opt,name=events" json:"events,omitempty"`
+	// The number of matching events that were not sent because the
+	// subscription's modifiers removed them on purpose: events merged by
+	// coalesce, events left out by sample, throttle or limit, and events
+	// after for_duration elapsed. Events lost because the client was not
+	// keeping up are not counted here; see GetEventsResponse.dropped_events.
+	DroppedEvents uint64 `protobuf:"varint,3,opt,name=dropped_events,json=droppedEvents" json:"dropped_events,omitempty"`
+	// Set in the last response sent for a subscription that was ended
+	// because the Sensor is shutting down
+	SensorStopping bool `protobuf:"varint,4,opt,name=sensor_stopping,json=sensorStopping" json:"sensor_stopping,omitempty"`
+}
+
+func (m *SubscriptionStatus) Reset()                    { *m = SubscriptionStatus{} }
+func (m *SubscriptionStatus) String() string            { return proto.CompactTextString(m) }
+func (*SubscriptionStatus) ProtoMessage()               {}
+func (*SubscriptionStatus) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
+
+func (m *SubscriptionStatus) GetSources() []*EventSourceStatus {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *SubscriptionStatus) GetEvents() uint64 {
+	if m != nil {
+		return m.Events
+	}
+	return 0
+}
+
+func (m *SubscriptionStatus) GetDroppedEvents() uint64 {
+	if m != nil {
+		return m.DroppedEvents
+	}
+	return 0
+}
+
+func (m *SubscriptionStatus) GetSensorStopping() bool {
+	if m != nil {
+		return m.SensorStopping
+	}
+	return false
+}
+
+// The status of an event source requested by a subscription
+type EventSourceStatus struct {
+	// The name of the event source (i.e. "file", "network", "container")
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// The number of kernel tracepoints and kprobes attached for the
+	// event source. Event sources that are not fed by the kernel
+	// always report zero.
+	KernelEvents uint32 `protobuf:"varint,2,opt,name=kernel_events,json=kernelEvents" json:"kernel_events,omitempty"`
+	// If not empty, the reason why the event source could not be
+	// attached. Events from this source will not be received.
+	Error string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *EventSourceStatus) Reset()                    { *m = EventSourceStatus{} }
+func (m *EventSourceStatus) String() string            { return proto.CompactTextString(m) }
+func (*EventSourceStatus) ProtoMessage()               {}
+func (*EventSourceStatus) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{3} }
+
+func (m *EventSourceStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventSourceStatus) GetKernelEvents() uint32 {
+	if m != nil {
+		return m.KernelEvents
+	}
+	return 0
+}
+
+func (m *EventSourceStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// A request message for the schema of telemetry events
+type GetSchemaRequest struct {
+}
+
+func (m *GetSchemaRequest) Reset()                    { *m = GetSchemaRequest{} }
+func (m *GetSchemaRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetSchemaRequest) ProtoMessage()               {}
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{4} }
+
+// A response message describing the schema of telemetry events
+type GetSchemaResponse struct {
+	// The version of the event schema. This is incremented whenever a
+	// change to the API is not backward compatible, and is also sent
+	// in the schema_version field of every Event.
+	SchemaVersion uint32 `protobuf:"varint,1,opt,name=schema_version,json=schemaVersion" json:"schema_version,omitempty"`
+	// A serialized google.protobuf.FileDescriptorSet containing the
+	// API proto files and their dependencies.
+	FileDescriptorSet []byte `protobuf:"bytes,2,opt,name=file_descriptor_set,json=fileDescriptorSet,proto3" json:"file_descriptor_set,omitempty"`
+}
+
+func (m *GetSchemaResponse) Reset()                    { *m = GetSchemaResponse{} }
+func (m *GetSchemaResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetSchemaResponse) ProtoMessage()               {}
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{5} }
+
+func (m *GetSchemaResponse) GetSchemaVersion() uint32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+func (m *GetSchemaResponse) GetFileDescriptorSet() []byte {
+	if m != nil {
+		return m.FileDescriptorSet
+	}
+	return nil
+}
+
 // A telemetry event received from a Sensor or Recorder.
 type TelemetryEvent struct {
 	// The time that the event was received by the backplane (in micros
@@ -73,7 +223,7 @@ type TelemetryEvent struct {
 func (m *TelemetryEvent) Reset()                    { *m = TelemetryEvent{} }
 func (m *TelemetryEvent) String() string            { return proto.CompactTextString(m) }
 func (*TelemetryEvent) ProtoMessage()               {}
-func (*TelemetryEvent) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
+func (*TelemetryEvent) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{6} }
 
 func (m *TelemetryEvent) GetPublishTimeMicros() int64 {
 	if m != nil {
@@ -99,6 +249,10 @@ func (m *TelemetryEvent) GetAck() []byte {
 func init() {
 	proto.RegisterType((*GetEventsRequest)(nil), "capsule8.api.v0.GetEventsRequest")
 	proto.RegisterType((*GetEventsResponse)(nil), "capsule8.api.v0.GetEventsResponse")
+	proto.RegisterType((*SubscriptionStatus)(nil), "capsule8.api.v0.SubscriptionStatus")
+	proto.RegisterType((*EventSourceStatus)(nil), "capsule8.api.v0.EventSourceStatus")
+	proto.RegisterType((*GetSchemaRequest)(nil), "capsule8.api.v0.GetSchemaRequest")
+	proto.RegisterType((*GetSchemaResponse)(nil), "capsule8.api.v0.GetSchemaResponse")
 	proto.RegisterType((*TelemetryEvent)(nil), "capsule8.api.v0.TelemetryEvent")
 }
 
@@ -115,6 +269,9 @@ const _ = grpc.SupportPackageIsVersion4
 type TelemetryServiceClient interface {
 	// Opens a new stream of telemetry events
 	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (TelemetryService_GetEventsClient, error)
+	// Returns the schema of the events sent by GetEvents, so that
+	// clients can detect and handle version skew with the Sensor.
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error)
 }
 
 type telemetryServiceClient struct {
@@ -157,11 +314,23 @@ func (x *telemetryServiceGetEventsClient) Recv() (*GetEventsResponse, error) {
 	return m, nil
 }
 
+func (c *telemetryServiceClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error) {
+	out := new(GetSchemaResponse)
+	err := grpc.Invoke(ctx, "/capsule8.api.v0.TelemetryService/GetSchema", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for TelemetryService service
 
 type TelemetryServiceServer interface {
 	// Opens a new stream of telemetry events
 	GetEvents(*GetEventsRequest, TelemetryService_GetEventsServer) error
+	// Returns the schema of the events sent by GetEvents, so that
+	// clients can detect and handle version skew with the Sensor.
+	GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaResponse, error)
 }
 
 func RegisterTelemetryServiceServer(s *grpc.Server, srv TelemetryServiceServer) {
@@ -189,10 +358,33 @@ func (x *telemetryServiceGetEventsServer) Send(m *GetEventsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TelemetryService_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/capsule8.api.v0.TelemetryService/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TelemetryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "capsule8.api.v0.TelemetryService",
 	HandlerType: (*TelemetryServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchema",
+			Handler:    _TelemetryService_GetSchema_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "GetEvents",
@@ -206,27 +398,42 @@ var _TelemetryService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("capsule8/api/v0/telemetry_service.proto", fileDescriptor2) }
 
 var fileDescriptor2 = []byte{
-	// 347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4b, 0xc3, 0x30,
-	0x18, 0x86, 0xc9, 0x8a, 0x03, 0xb3, 0xe1, 0xb6, 0x88, 0x52, 0xa6, 0xe2, 0x8c, 0x88, 0x43, 0x24,
-	0x1d, 0xf3, 0xa0, 0x78, 0x53, 0x10, 0x2f, 0x7a, 0xe9, 0xe6, 0xc5, 0xcb, 0x68, 0xcb, 0xc7, 0x16,
-	0xd6, 0x36, 0xb1, 0x49, 0x0b, 0x9e, 0x14, 0xff, 0x82, 0x3f, 0xcd, 0xbf, 0xe0, 0x0f, 0x91, 0xa5,
-	0x5b, 0xd9, 0x5a, 0xf0, 0x16, 0x78, 0x9f, 0xf7, 0xfb, 0xde, 0x7c, 0x2f, 0x3e, 0x0f, 0x3c, 0xa9,
-	0xd2, 0x10, 0x6e, 0x1c, 0x4f, 0x72, 0x27, 0x1b, 0x38, 0x1a, 0x42, 0x88, 0x40, 0x27, 0xef, 0x13,
-	0x05, 0x49, 0xc6, 0x03, 0x60, 0x32, 0x11, 0x5a, 0x90, 0xd6, 0x0a, 0x64, 0x9e, 0xe4, 0x2c, 0x1b,
-	0x74, 0x69, 0xd9, 0xa9, 0x52, 0x5f, 0x05, 0x09, 0x97, 0x9a, 0x8b, 0x38, 0x37, 0x75, 0x0f, 0xca,
-	0x0c, 0x64, 0x10, 0xeb, 0xa5, 0x78, 0x38, 0x15, 0x62, 0x1a, 0x82, 0x91, 0xbc, 0x38, 0x16, 0xda,
-	0x5b, 0x38, 0x55, 0xae, 0xd2, 0x17, 0xdc, 0x7e, 0x04, 0xfd, 0xb0, 0xe0, 0x95, 0x0b, 0x6f, 0x29,
-	0x28, 0x4d, 0xee, 0x70, 0x73, 0x7d, 0x89, 0x8d, 0x7a, 0xa8, 0xdf, 0x18, 0x1e, 0xb1, 0x52, 0x34,
-	0x36, 0x5a, 0x83, 0xdc, 0x0d, 0x0b, 0x7d, 0xc2, 0x9d, 0xb5, 0xb1, 0x4a, 0x8a, 0x58, 0x01, 0xb9,
-	0xc6, 0x75, 0x13, 0x4c, 0xd9, 0xa8, 0x67, 0xf5, 0x1b, 0xc3, 0xe3, 0xca, 0xc4, 0xf1, 0xea, 0x2a,
-	0xc6, 0xe9, 0x2e, 0x71, 0xfa, 0x89, 0xf0, 0xce, 0xa6, 0x44, 0x18, 0xde, 0x95, 0xa9, 0x1f, 0x72,
-	0x35, 0x9b, 0x68, 0x1e, 0xc1, 0x24, 0xe2, 0x41, 0x22, 0x94, 0x89, 0x6a, 0xb9, 0x9d, 0xa5, 0x34,
-	0xe6, 0x11, 0x3c, 0x1b, 0x81, 0x5c, 0xe2, 0x2d, 0x33, 0xcc, 0xae, 0x99, 0xcf, 0xec, 0x57, 0x56,
-	0xe7, 0x1b, 0x73, 0x88, 0xb4, 0xb1, 0xe5, 0x05, 0x73, 0xdb, 0xea, 0xa1, 0x7e, 0xd3, 0x5d, 0x3c,
-	0x87, 0x1f, 0xb8, 0x5d, 0x24, 0x18, 0xe5, 0x8d, 0x91, 0x39, 0xde, 0x2e, 0x3e, 0x49, 0x4e, 0x2a,
-	0x13, 0xcb, 0x77, 0xed, 0xd2, 0xff, 0x90, 0xfc, 0x46, 0x74, 0xef, 0xeb, 0xe7, 0xf7, 0xbb, 0xd6,
-	0xa2, 0xb8, 0xa8, 0x51, 0xdd, 0xa2, 0x8b, 0x01, 0xba, 0x3f, 0x7b, 0x3d, 0x9d, 0x72, 0x3d, 0x4b,
-	0x7d, 0x16, 0x88, 0xc8, 0x29, 0x0a, 0x2f, 0x35, 0xef, 0xd7, 0x4d, 0xad, 0x57, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x08, 0xdc, 0xb1, 0x4a, 0x71, 0x02, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x96, 0x9b, 0x36, 0x90, 0x69, 0x92, 0x36, 0x0b, 0x54, 0x51, 0x00, 0x11, 0xb6, 0xaa, 0x1a,
+	0x21, 0xe4, 0x54, 0xe1, 0x00, 0x02, 0x2e, 0x20, 0x10, 0x27, 0x2e, 0xeb, 0xc2, 0x81, 0x8b, 0xe5,
+	0xb8, 0xd3, 0x64, 0x89, 0xed, 0x35, 0xbb, 0xeb, 0x48, 0xdc, 0x10, 0xaf, 0xc0, 0x8b, 0x70, 0xe6,
+	0x35, 0x78, 0x05, 0x24, 0x5e, 0x03, 0x79, 0x77, 0x63, 0xe5, 0x8f, 0xdc, 0xec, 0xf9, 0xbe, 0x99,
+	0xef, 0xdb, 0x99, 0xd9, 0x85, 0xf3, 0x38, 0xca, 0x55, 0x91, 0xe0, 0xb3, 0x61, 0x94, 0xf3, 0xe1,
+	0xfc, 0x62, 0xa8, 0x31, 0xc1, 0x14, 0xb5, 0xfc, 0x1a, 0x2a, 0x94, 0x73, 0x1e, 0xa3, 0x9f, 0x4b,
+	0xa1, 0x05, 0x39, 0x5a, 0x10, 0xfd, 0x28, 0xe7, 0xfe, 0xfc, 0xa2, 0x47, 0xd7, 0x33, 0x55, 0x31,
+	0x56, 0xb1, 0xe4, 0xb9, 0xe6, 0x22, 0xb3, 0x49, 0xbd, 0xbb, 0xeb, 0x1c, 0x9c, 0x63, 0xa6, 0x1d,
+	0x78, 0x6f, 0x22, 0xc4, 0x24, 0x41, 0x03, 0x45, 0x59, 0x26, 0x74, 0x54, 0x66, 0x2a, 0x8b, 0xd2,
+	0x0f, 0x70, 0xfc, 0x0e, 0xf5, 0xdb, 0x92, 0xaf, 0x18, 0x7e, 0x29, 0x50, 0x69, 0xf2, 0x0a, 0x9a,
+	0xcb, 0x22, 0x5d, 0xaf, 0xef, 0x0d, 0x0e, 0x47, 0xf7, 0xfd, 0x35, 0x6b, 0x7e, 0xb0, 0x44, 0x62,
+	0x2b, 0x29, 0xf4, 0xa7, 0x07, 0x9d, 0xa5, 0xba, 0x2a, 0x17, 0x99, 0x42, 0xf2, 0x14, 0xea, 0xc6,
+	0x99, 0xea, 0x7a, 0xfd, 0xda, 0xe0, 0x70, 0xf4, 0x60, 0xa3, 0xe4, 0xe5, 0xa2, 0x2d, 0x26, 0x93,
+	0x39, 0x3a, 0x39, 0x83, 0xf6, 0x95, 0x14, 0x79, 0x8e, 0x57, 0xa1, 0x2b, 0xb0, 0xd7, 0xf7, 0x06,
+	0xfb, 0xac, 0xe5, 0xa2, 0x56, 0x87, 0xbc, 0x80, 0xba, 0xd2, 0x91, 0x2e, 0x54, 0xb7, 0x66, 0x2c,
+	0x9f, 0xee, 0xb4, 0x1c, 0x18, 0x2a, 0x73, 0x29, 0xf4, 0x97, 0x07, 0x64, 0x13, 0x26, 0x2f, 0xe1,
+	0x86, 0x12, 0x85, 0x8c, 0x71, 0x61, 0x9a, 0x6e, 0x14, 0x35, 0xea, 0x81, 0x21, 0xb9, 0x9a, 0x8b,
+	0x14, 0x72, 0x02, 0xf5, 0x15, 0xc3, 0xff, 0x3f, 0x50, 0x6d, 0xdb, 0x81, 0xce, 0xe1, 0x48, 0x61,
+	0xa6, 0x84, 0x0c, 0x95, 0x16, 0x79, 0xce, 0xb3, 0x49, 0x77, 0xbf, 0xef, 0x0d, 0x6e, 0xb2, 0xb6,
+	0x0d, 0x07, 0x2e, 0x4a, 0xc7, 0xd0, 0xd9, 0x70, 0x41, 0x08, 0xec, 0x67, 0x51, 0x8a, 0x66, 0x7e,
+	0x0d, 0x66, 0xbe, 0xc9, 0x29, 0xb4, 0x66, 0x28, 0x33, 0x4c, 0x96, 0x1b, 0xd9, 0x62, 0x4d, 0x1b,
+	0x74, 0xb2, 0xb7, 0xe1, 0x00, 0xa5, 0x14, 0xd2, 0x98, 0x6a, 0x30, 0xfb, 0x43, 0x89, 0x59, 0x95,
+	0x20, 0x9e, 0x62, 0x1a, 0xb9, 0x55, 0xa1, 0x9f, 0xa1, 0xb3, 0x14, 0x73, 0x63, 0x3e, 0x83, 0xb6,
+	0x32, 0x91, 0x70, 0x8e, 0x52, 0x2d, 0x36, 0xa8, 0xc5, 0x5a, 0x36, 0xfa, 0xd1, 0x06, 0x89, 0x0f,
+	0xb7, 0xae, 0x79, 0x82, 0xe1, 0x15, 0xda, 0x9e, 0x97, 0xa7, 0x44, 0x6d, 0x0c, 0x35, 0x59, 0xa7,
+	0x84, 0xde, 0x54, 0x48, 0x80, 0x9a, 0x7e, 0xf3, 0xa0, 0xbd, 0xba, 0x1f, 0x65, 0x89, 0xbc, 0x18,
+	0x27, 0x5c, 0x4d, 0x43, 0xcd, 0x53, 0x0c, 0x53, 0x1e, 0x4b, 0xa1, 0x8c, 0x5c, 0x8d, 0x75, 0x1c,
+	0x74, 0xc9, 0x53, 0x7c, 0x6f, 0x00, 0xf2, 0x18, 0x0e, 0xcc, 0xb1, 0x8d, 0xc8, 0xe1, 0xe8, 0x64,
+	0xfb, 0x28, 0x99, 0x25, 0x91, 0x63, 0xa8, 0x45, 0xf1, 0xcc, 0x34, 0xa1, 0xc9, 0xca, 0xcf, 0xd1,
+	0x5f, 0x0f, 0x8e, 0x2b, 0x0b, 0x81, 0xbd, 0xb8, 0x64, 0x06, 0x8d, 0x6a, 0xd5, 0xc9, 0xc3, 0x8d,
+	0x92, 0xeb, 0xd7, 0xab, 0x47, 0x77, 0x51, 0x6c, 0x0b, 0xe9, 0x9d, 0xef, 0xbf, 0xff, 0xfc, 0xd8,
+	0x3b, 0xa2, 0x50, 0xdd, 0x66, 0xf5, 0xdc, 0x7b, 0x74, 0xe1, 0x91, 0x6b, 0x68, 0x54, 0x0d, 0xdf,
+	0x2e, 0xb6, 0x32, 0xa0, 0x1e, 0xdd, 0x45, 0x71, 0x62, 0xc4, 0x88, 0x35, 0x89, 0x11, 0xb3, 0x33,
+	0x7a, 0x7d, 0xf6, 0xe9, 0x74, 0xc2, 0xf5, 0xb4, 0x18, 0xfb, 0xb1, 0x48, 0x87, 0xd5, 0xfb, 0xb2,
+	0xf6, 0xd0, 0x8c, 0xeb, 0xe6, 0x15, 0x79, 0xf2, 0x6f, 0x00, 0x23, 0xe3, 0xa6, 0xb5, 0xe0, 0x04,
+	0x00, 0x00,
 }
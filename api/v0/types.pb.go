@@ -10,6 +10,7 @@ It is generated from these files:
 	capsule8/api/v0/telemetry_service.proto
 	capsule8/api/v0/subscription.proto
 	capsule8/api/v0/expression.proto
+	capsule8/api/v0/state_service.proto
 
 It has these top-level messages:
 	IPv4Address
@@ -24,11 +25,22 @@ It has these top-level messages:
 	ProcessEvent
 	SyscallEvent
 	FileEvent
+	KubernetesMetadata
 	Process
 	KernelFunctionCallEvent
 	NetworkEvent
+	KernelLoadEvent
+	PrivilegeEvent
+	MemoryEvent
+	AlertEvent
+	SensorMetricsEvent
+	LostEventsEvent
 	GetEventsRequest
 	GetEventsResponse
+	SubscriptionStatus
+	EventSourceStatus
+	GetSchemaRequest
+	GetSchemaResponse
 	TelemetryEvent
 	Subscription
 	ContainerFilter
@@ -38,15 +50,31 @@ It has these top-level messages:
 	FileEventFilter
 	KernelFunctionCallFilter
 	NetworkEventFilter
+	KernelLoadEventFilter
+	PrivilegeEventFilter
+	MemoryEventFilter
+	AlertEventFilter
+	SensorMetricsEventFilter
 	ContainerEventFilter
 	ChargenEventFilter
 	TickerEventFilter
 	Modifier
 	ThrottleModifier
 	LimitModifier
+	SampleModifier
+	CoalesceModifier
+	BatchModifier
 	Value
 	BinaryOp
 	Expression
+	GetProcessesRequest
+	ProcessState
+	GetProcessesResponse
+	GetContainersRequest
+	ContainerState
+	GetContainersResponse
+	GetRecentEventsRequest
+	GetRecentEventsResponse
 */
 package v0
 
@@ -0,0 +1,250 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"path"
+	"text/template"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/jsonpb"
+)
+
+// defaultPartition lays objects out in Hive-style partitions, which both
+// Athena and BigQuery external tables can prune on.
+const defaultPartition = "tenant={{.Tenant}}/date={{.Year}}-{{.Month}}-{{.Day}}/hour={{.Hour}}"
+
+// A batch that keeps failing to upload is dropped once it grows to this
+// many times the size threshold, so an unreachable bucket can't exhaust
+// memory.
+const maxPendingFactor = 4
+
+// uploader stores a completed object under the given key.
+type uploader interface {
+	upload(key string, body []byte) error
+}
+
+// partitionFields are the fields available to the partition template.
+type partitionFields struct {
+	Tenant   string
+	SensorID string
+	Year     string
+	Month    string
+	Day      string
+	Hour     string
+}
+
+type batch struct {
+	partition string
+	opened    time.Time
+	events    int
+	buf       bytes.Buffer
+
+	// Set once an upload of the batch has failed. Failed batches are
+	// only retried by flushExpired and flushAll.
+	failed bool
+}
+
+// archiver batches telemetry events as newline-delimited JSON into one
+// object per partition and hands each object to an uploader once it
+// reaches maxBytes or has been open for maxAge.
+type archiver struct {
+	up        uploader
+	prefix    string
+	tenant    string
+	writerID  string
+	partition *template.Template
+	maxBytes  int
+	maxAge    time.Duration
+	compress  bool
+
+	marshaler jsonpb.Marshaler
+	batches   map[string]*batch
+	seq       uint64
+}
+
+func newArchiver(up uploader, prefix, tenant, writerID, partition string,
+	maxBytes int, maxAge time.Duration, compress bool) (*archiver, error) {
+
+	if len(partition) == 0 {
+		partition = defaultPartition
+	}
+	t, err := template.New("partition").Option("missingkey=error").Parse(partition)
+	if err != nil {
+		return nil, err
+	}
+	if maxBytes <= 0 {
+		return nil, fmt.Errorf("Invalid object size threshold %d", maxBytes)
+	}
+	if maxAge <= 0 {
+		return nil, fmt.Errorf("Invalid object age threshold %s", maxAge)
+	}
+
+	return &archiver{
+		up:        up,
+		prefix:    prefix,
+		tenant:    tenant,
+		writerID:  writerID,
+		partition: t,
+		maxBytes:  maxBytes,
+		maxAge:    maxAge,
+		compress:  compress,
+		batches:   make(map[string]*batch),
+	}, nil
+}
+
+// eventTime is the time used to partition an event: the publish time if
+// one was set, otherwise the time it was received.
+func eventTime(te *api.TelemetryEvent, received time.Time) time.Time {
+	if te.PublishTimeMicros > 0 {
+		return time.Unix(0, te.PublishTimeMicros*int64(time.Microsecond))
+	}
+	return received
+}
+
+func (a *archiver) partitionFor(e *api.Event, t time.Time) (string, error) {
+	t = t.UTC()
+	fields := partitionFields{
+		Tenant:   a.tenant,
+		SensorID: e.SensorId,
+		Year:     fmt.Sprintf("%04d", t.Year()),
+		Month:    fmt.Sprintf("%02d", t.Month()),
+		Day:      fmt.Sprintf("%02d", t.Day()),
+		Hour:     fmt.Sprintf("%02d", t.Hour()),
+	}
+
+	var b bytes.Buffer
+	if err := a.partition.Execute(&b, fields); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// add appends an event to the batch for its partition, uploading the batch
+// if that takes it over the size threshold. A batch that has already failed
+// to upload isn't retried here, so that an unreachable bucket doesn't cost
+// an upload attempt per event.
+func (a *archiver) add(te *api.TelemetryEvent, now time.Time) error {
+	if te.Event == nil {
+		return nil
+	}
+
+	partition, err := a.partitionFor(te.Event, eventTime(te, now))
+	if err != nil {
+		return err
+	}
+
+	b, ok := a.batches[partition]
+	if !ok {
+		b = &batch{
+			partition: partition,
+			opened:    now,
+		}
+		a.batches[partition] = b
+	}
+
+	if err = a.marshaler.Marshal(&b.buf, te.Event); err != nil {
+		return err
+	}
+	b.buf.WriteByte('\n')
+	b.events++
+
+	if b.failed {
+		if b.buf.Len() >= maxPendingFactor*a.maxBytes {
+			a.drop(b)
+		}
+		return nil
+	}
+	if b.buf.Len() >= a.maxBytes {
+		return a.flush(b)
+	}
+	return nil
+}
+
+// drop discards a batch that has grown too large to keep.
+func (a *archiver) drop(b *batch) {
+	glog.Errorf("Dropping %d events for %s", b.events, b.partition)
+	delete(a.batches, b.partition)
+}
+
+// flushExpired uploads every batch that has been open for at least maxAge.
+func (a *archiver) flushExpired(now time.Time) error {
+	var lastErr error
+	for _, b := range a.batches {
+		if now.Sub(b.opened) >= a.maxAge {
+			if err := a.flush(b); err != nil {
+				lastErr = err
+			}
+		}
+	}
+	return lastErr
+}
+
+// flushAll uploads every open batch.
+func (a *archiver) flushAll() error {
+	var lastErr error
+	for _, b := range a.batches {
+		if err := a.flush(b); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// objectKey names an object so that keys from different writers and from
+// successive batches of the same partition never collide.
+func (a *archiver) objectKey(b *batch) string {
+	a.seq++
+	name := fmt.Sprintf("%s-%d-%06d.json", a.writerID,
+		b.opened.UnixNano(), a.seq)
+	if a.compress {
+		name += ".gz"
+	}
+	return path.Join(a.prefix, b.partition, name)
+}
+
+// flush uploads a batch. A batch that fails to upload is kept so that a
+// later flush retries it, unless it has grown too large to keep.
+func (a *archiver) flush(b *batch) error {
+	body := b.buf.Bytes()
+	if a.compress {
+		var z bytes.Buffer
+		w := gzip.NewWriter(&z)
+		w.Write(body)
+		if err := w.Close(); err != nil {
+			return err
+		}
+		body = z.Bytes()
+	}
+
+	key := a.objectKey(b)
+	if err := a.up.upload(key, body); err != nil {
+		b.failed = true
+		if b.buf.Len() >= maxPendingFactor*a.maxBytes {
+			a.drop(b)
+		}
+		return fmt.Errorf("Upload of %s failed: %s", key, err)
+	}
+
+	glog.V(1).Infof("Uploaded %d events (%d bytes) to %s",
+		b.events, len(body), key)
+	delete(a.batches, b.partition)
+	return nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+type fakeUploader struct {
+	objects  map[string][]byte
+	err      error
+	attempts int
+}
+
+func (u *fakeUploader) upload(key string, body []byte) error {
+	u.attempts++
+	if u.err != nil {
+		return u.err
+	}
+	if u.objects == nil {
+		u.objects = make(map[string][]byte)
+	}
+	u.objects[key] = body
+	return nil
+}
+
+var testTime = time.Date(2017, 11, 1, 12, 30, 0, 0, time.UTC)
+
+func testEvent(id string) *api.TelemetryEvent {
+	return &api.TelemetryEvent{
+		Event: &api.Event{
+			Id:       id,
+			SensorId: "s1",
+		},
+	}
+}
+
+func TestArchiverPartitions(t *testing.T) {
+	up := &fakeUploader{}
+	a, err := newArchiver(up, "c8", "acme", "host1", "", 1<<20,
+		time.Minute, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a.add(testEvent("a"), testTime)
+	a.add(testEvent("b"), testTime.Add(time.Hour))
+	if len(a.batches) != 2 {
+		t.Fatalf("Expected 2 open batches, got %d", len(a.batches))
+	}
+
+	if err = a.flushAll(); err != nil {
+		t.Fatal(err)
+	}
+	if len(up.objects) != 2 {
+		t.Fatalf("Expected 2 objects, got %d", len(up.objects))
+	}
+
+	prefix := "c8/tenant=acme/date=2017-11-01/hour=12/host1-"
+	found := false
+	for key, body := range up.objects {
+		if strings.HasPrefix(key, prefix) {
+			found = true
+			if !strings.Contains(string(body), `"id":"a"`) {
+				t.Errorf("Expected event a in %s, got %q", key, body)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("Expected an object with prefix %s", prefix)
+	}
+}
+
+func TestArchiverThresholds(t *testing.T) {
+	up := &fakeUploader{}
+	a, err := newArchiver(up, "", "t", "host1", "{{.SensorID}}", 64,
+		time.Minute, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a.add(testEvent("a"), testTime)
+	if len(up.objects) != 0 {
+		t.Fatal("Expected no upload below the size threshold")
+	}
+	a.add(testEvent(strings.Repeat("x", 64)), testTime)
+	if len(up.objects) != 1 {
+		t.Fatalf("Expected upload at the size threshold, got %d",
+			len(up.objects))
+	}
+
+	a.add(testEvent("c"), testTime)
+	a.flushExpired(testTime.Add(time.Second))
+	if len(up.objects) != 1 {
+		t.Fatal("Expected no upload before the age threshold")
+	}
+	a.flushExpired(testTime.Add(time.Minute))
+	if len(up.objects) != 2 {
+		t.Fatalf("Expected upload at the age threshold, got %d",
+			len(up.objects))
+	}
+}
+
+func TestArchiverGzip(t *testing.T) {
+	up := &fakeUploader{}
+	a, err := newArchiver(up, "", "t", "host1", "", 1<<20, time.Minute,
+		true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a.add(testEvent("a"), testTime)
+	a.flushAll()
+
+	for key, body := range up.objects {
+		if !strings.HasSuffix(key, ".json.gz") {
+			t.Errorf("Expected .json.gz key, got %s", key)
+		}
+		r, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), `"id":"a"`) {
+			t.Errorf("Expected event a, got %q", data)
+		}
+	}
+}
+
+func TestArchiverUploadFailure(t *testing.T) {
+	up := &fakeUploader{err: errors.New("unavailable")}
+	a, err := newArchiver(up, "", "t", "host1", "{{.SensorID}}", 1<<20,
+		time.Minute, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a.add(testEvent("a"), testTime)
+	if err = a.flushAll(); err == nil {
+		t.Fatal("Expected upload error")
+	}
+	if len(a.batches) != 1 {
+		t.Fatal("Expected failed batch to be kept for retry")
+	}
+
+	up.err = nil
+	if err = a.flushAll(); err != nil {
+		t.Fatal(err)
+	}
+	if len(up.objects) != 1 || len(a.batches) != 0 {
+		t.Error("Expected retried batch to be uploaded")
+	}
+}
+
+func TestArchiverRetriesOnlyWhenExpired(t *testing.T) {
+	up := &fakeUploader{err: errors.New("unavailable")}
+	a, err := newArchiver(up, "", "t", "host1", "{{.SensorID}}", 64,
+		time.Minute, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	big := strings.Repeat("x", 64)
+	if err = a.add(testEvent(big), testTime); err == nil {
+		t.Fatal("Expected upload error")
+	}
+	if up.attempts != 1 {
+		t.Fatalf("Expected 1 upload attempt, got %d", up.attempts)
+	}
+
+	// Adding to a failed batch doesn't retry it
+	a.add(testEvent("a"), testTime)
+	a.add(testEvent("b"), testTime)
+	if up.attempts != 1 {
+		t.Errorf("Expected no retries from add, got %d attempts", up.attempts)
+	}
+
+	a.flushExpired(testTime.Add(time.Minute))
+	if up.attempts != 2 {
+		t.Errorf("Expected expired batch to be retried, got %d attempts",
+			up.attempts)
+	}
+
+	// A failed batch is dropped once it grows too large, without another
+	// upload attempt
+	for i := 0; i < 2*maxPendingFactor && len(a.batches) > 0; i++ {
+		a.add(testEvent(big), testTime)
+	}
+	if len(a.batches) != 0 {
+		t.Error("Expected oversized failed batch to be dropped")
+	}
+	if up.attempts != 2 {
+		t.Errorf("Expected no upload attempt when dropping, got %d attempts",
+			up.attempts)
+	}
+}
+
+func TestArchiverErrors(t *testing.T) {
+	if _, err := newArchiver(nil, "", "", "", "{{", 1, time.Second, false); err == nil {
+		t.Error("Expected error for invalid partition template")
+	}
+	if _, err := newArchiver(nil, "", "", "", "", 0, time.Second, false); err == nil {
+		t.Error("Expected error for invalid size threshold")
+	}
+	if _, err := newArchiver(nil, "", "", "", "", 1, 0, false); err == nil {
+		t.Error("Expected error for invalid age threshold")
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The archiver command subscribes to telemetry events from a Sensor and
+// archives them to S3 (or any S3-compatible store, such as GCS through its
+// interoperability endpoint) as time-partitioned newline-delimited JSON
+// objects for later analysis in Athena or BigQuery.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/capsule8/capsule8/pkg/services"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/jsonpb"
+
+	"golang.org/x/net/context"
+
+	"google.golang.org/grpc"
+)
+
+var config struct {
+	admin        string
+	endpoint     string
+	subscription string
+	bucket       string
+	prefix       string
+	tenant       string
+	partition    string
+	maxBytes     int
+	maxAge       time.Duration
+	compress     bool
+	region       string
+	s3Endpoint   string
+}
+
+func init() {
+	flag.StringVar(&config.admin, "admin", "",
+		"address to serve /healthz, /metrics and /debug/pprof on")
+	flag.StringVar(&config.endpoint, "endpoint",
+		"unix:/var/run/capsule8/sensor.sock",
+		"Capsule8 gRPC API endpoint")
+	flag.StringVar(&config.subscription, "subscription", "",
+		"file containing the JSON-encoded Subscription to archive")
+	flag.StringVar(&config.bucket, "bucket", "",
+		"bucket to archive events to")
+	flag.StringVar(&config.prefix, "prefix", "capsule8",
+		"key prefix for archived objects")
+	flag.StringVar(&config.tenant, "tenant", "default",
+		"tenant name available to the partition template")
+	flag.StringVar(&config.partition, "partition", defaultPartition,
+		"text/template for the partition part of object keys")
+	flag.IntVar(&config.maxBytes, "max-bytes", 64*1024*1024,
+		"upload an object once it holds this many bytes of events")
+	flag.DurationVar(&config.maxAge, "max-age", 5*time.Minute,
+		"upload an object once it has been open this long")
+	flag.BoolVar(&config.compress, "gzip", true,
+		"gzip archived objects")
+	flag.StringVar(&config.region, "region", "",
+		"region of the bucket (defaults to the AWS SDK's configuration)")
+	flag.StringVar(&config.s3Endpoint, "s3-endpoint", "",
+		"S3-compatible endpoint URL, e.g. https://storage.googleapis.com")
+}
+
+// Custom gRPC Dialer that understands "unix:/path/to/sock" as well as TCP addrs
+func dialer(addr string, timeout time.Duration) (net.Conn, error) {
+	var network, address string
+
+	parts := strings.Split(addr, ":")
+	if len(parts) > 1 && parts[0] == "unix" {
+		network = "unix"
+		address = parts[1]
+	} else {
+		network = "tcp"
+		address = addr
+	}
+
+	return net.DialTimeout(network, address, timeout)
+}
+
+type s3Uploader struct {
+	svc      s3iface.S3API
+	bucket   string
+	compress bool
+}
+
+func (u *s3Uploader) upload(key string, body []byte) error {
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(u.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String("application/x-ndjson"),
+	}
+	if u.compress {
+		input.ContentEncoding = aws.String("gzip")
+	}
+
+	_, err := u.svc.PutObject(input)
+	return err
+}
+
+func newS3Uploader() (*s3Uploader, error) {
+	awsConfig := aws.NewConfig()
+	if len(config.region) > 0 {
+		awsConfig = awsConfig.WithRegion(config.region)
+	}
+	if len(config.s3Endpoint) > 0 {
+		awsConfig = awsConfig.WithEndpoint(config.s3Endpoint).
+			WithS3ForcePathStyle(true)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Uploader{
+		svc:      s3.New(sess),
+		bucket:   config.bucket,
+		compress: config.compress,
+	}, nil
+}
+
+// The number of events received from the Sensor, for /metrics
+var eventsReceived uint64
+
+func serveAdmin() {
+	admin := services.NewAdminService(config.admin)
+	admin.AddMetrics(func(mw *services.MetricsWriter) {
+		mw.Counter("capsule8_archiver_events_total",
+			"Number of events received for archiving.",
+			float64(atomic.LoadUint64(&eventsReceived)))
+	})
+	go admin.Serve()
+}
+
+func fatal(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+func main() {
+	flag.Parse()
+
+	if len(config.subscription) == 0 || len(config.bucket) == 0 {
+		fatal("-subscription and -bucket are required")
+	}
+
+	f, err := os.Open(config.subscription)
+	if err != nil {
+		fatal("%s", err)
+	}
+	sub := &api.Subscription{}
+	err = jsonpb.Unmarshal(f, sub)
+	f.Close()
+	if err != nil {
+		fatal("%s: %s", config.subscription, err)
+	}
+
+	up, err := newS3Uploader()
+	if err != nil {
+		fatal("%s", err)
+	}
+
+	hostname, _ := os.Hostname()
+	a, err := newArchiver(up, config.prefix, config.tenant, hostname,
+		config.partition, config.maxBytes, config.maxAge, config.compress)
+	if err != nil {
+		fatal("%s", err)
+	}
+
+	if len(config.admin) > 0 {
+		serveAdmin()
+	}
+
+	conn, err := grpc.Dial(config.endpoint,
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure())
+	if err != nil {
+		fatal("grpc.Dial: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := api.NewTelemetryServiceClient(conn).GetEvents(ctx,
+		&api.GetEventsRequest{
+			Subscription: sub,
+		})
+	if err != nil {
+		fatal("GetEvents: %s", err)
+	}
+
+	// Exit cleanly on Control-C, uploading whatever has been batched
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	events := make(chan []*api.TelemetryEvent, 1024)
+	go func() {
+		defer close(events)
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				glog.Infof("Recv: %s", err)
+				return
+			}
+			if len(resp.Events) > 0 {
+				atomic.AddUint64(&eventsReceived,
+					uint64(len(resp.Events)))
+				events <- resp.Events
+			}
+		}
+	}()
+
+	interval := config.maxAge / 4
+	if interval < time.Second {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case tes, ok := <-events:
+			if !ok {
+				if err = a.flushAll(); err != nil {
+					glog.Error(err)
+				}
+				return
+			}
+			now := time.Now()
+			for _, te := range tes {
+				if err = a.add(te, now); err != nil {
+					glog.Warning(err)
+				}
+			}
+
+		case now := <-ticker.C:
+			if err = a.flushExpired(now); err != nil {
+				glog.Warning(err)
+			}
+		}
+	}
+}
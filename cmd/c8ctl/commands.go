@@ -0,0 +1,359 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/capsule8/capsule8/pkg/validate"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	"golang.org/x/net/context"
+)
+
+// defaultSubscription is tailed when no subscription file is given.
+var defaultSubscription = &api.Subscription{
+	EventFilter: &api.EventFilter{
+		ProcessEvents: []*api.ProcessEventFilter{
+			&api.ProcessEventFilter{
+				Type: api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC,
+			},
+			&api.ProcessEventFilter{
+				Type: api.ProcessEventType_PROCESS_EVENT_TYPE_FORK,
+			},
+			&api.ProcessEventFilter{
+				Type: api.ProcessEventType_PROCESS_EVENT_TYPE_EXIT,
+			},
+		},
+		ContainerEvents: []*api.ContainerEventFilter{
+			&api.ContainerEventFilter{
+				Type: api.ContainerEventType_CONTAINER_EVENT_TYPE_CREATED,
+			},
+			&api.ContainerEventFilter{
+				Type: api.ContainerEventType_CONTAINER_EVENT_TYPE_RUNNING,
+			},
+			&api.ContainerEventFilter{
+				Type: api.ContainerEventType_CONTAINER_EVENT_TYPE_EXITED,
+			},
+			&api.ContainerEventFilter{
+				Type: api.ContainerEventType_CONTAINER_EVENT_TYPE_DESTROYED,
+			},
+		},
+	},
+}
+
+// printer writes protobuf messages as JSON, either indented or one
+// message per line.
+type printer struct {
+	w         io.Writer
+	marshaler jsonpb.Marshaler
+}
+
+func newPrinter(w io.Writer, compact bool) *printer {
+	p := &printer{w: w}
+	if !compact {
+		p.marshaler.Indent = "  "
+	}
+	return p
+}
+
+func (p *printer) print(msg proto.Message) error {
+	s, err := p.marshaler.MarshalToString(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(p.w, s)
+	return err
+}
+
+// readSubscription reads a JSON-encoded Subscription from the named file,
+// or from stdin if the name is "-", and checks it the same way the Sensor
+// does.
+func readSubscription(name string) (*api.Subscription, error) {
+	r := io.Reader(os.Stdin)
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	sub := &api.Subscription{}
+	if err := jsonpb.Unmarshal(r, sub); err != nil {
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+	if err := validate.Subscription(sub); err != nil {
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+	return sub, nil
+}
+
+// parseFlags parses a subcommand's flags, returning the remaining
+// positional arguments. At most maxArgs of them are allowed.
+func parseFlags(fs *flag.FlagSet, args []string, maxArgs int) ([]string, error) {
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > maxArgs {
+		return nil, fmt.Errorf("unexpected arguments: %s",
+			strings.Join(fs.Args()[maxArgs:], " "))
+	}
+	return fs.Args(), nil
+}
+
+func requestContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), config.timeout)
+}
+
+func checkCommand(args []string) error {
+	fs := flag.NewFlagSet("check", flag.ContinueOnError)
+	args, err := parseFlags(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("a subscription file is required")
+	}
+
+	sub, err := readSubscription(args[0])
+	if err != nil {
+		return err
+	}
+	return newPrinter(os.Stdout, config.compact).print(sub)
+}
+
+func tailCommand(args []string) error {
+	fs := flag.NewFlagSet("tail", flag.ContinueOnError)
+	args, err := parseFlags(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	sub := defaultSubscription
+	if len(args) > 0 {
+		if sub, err = readSubscription(args[0]); err != nil {
+			return err
+		}
+	}
+
+	conn, err := dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Stop cleanly on Control-C
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	stream, err := api.NewTelemetryServiceClient(conn).GetEvents(ctx,
+		&api.GetEventsRequest{
+			Subscription: sub,
+		})
+	if err != nil {
+		return err
+	}
+
+	out := newPrinter(os.Stdout, config.compact)
+	status := newPrinter(os.Stderr, config.compact)
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		// Statuses go to stderr so that stdout remains a stream of
+		// events.
+		if resp.Status != nil {
+			status.print(resp.Status)
+		}
+		if resp.DroppedEvents > 0 {
+			fmt.Fprintf(os.Stderr, "dropped %d events\n",
+				resp.DroppedEvents)
+		}
+		for _, te := range resp.Events {
+			if err = out.print(te.Event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func processesCommand(args []string) error {
+	fs := flag.NewFlagSet("processes", flag.ContinueOnError)
+	containerID := fs.String("container", "", "only print processes in this container")
+	if _, err := parseFlags(fs, args, 0); err != nil {
+		return err
+	}
+
+	conn, err := dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := requestContext()
+	defer cancel()
+
+	resp, err := api.NewStateServiceClient(conn).GetProcesses(ctx,
+		&api.GetProcessesRequest{
+			ContainerId: *containerID,
+		})
+	if err != nil {
+		return err
+	}
+	return newPrinter(os.Stdout, config.compact).print(resp)
+}
+
+func containersCommand(args []string) error {
+	fs := flag.NewFlagSet("containers", flag.ContinueOnError)
+	if _, err := parseFlags(fs, args, 0); err != nil {
+		return err
+	}
+
+	conn, err := dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := requestContext()
+	defer cancel()
+
+	resp, err := api.NewStateServiceClient(conn).GetContainers(ctx,
+		&api.GetContainersRequest{})
+	if err != nil {
+		return err
+	}
+	return newPrinter(os.Stdout, config.compact).print(resp)
+}
+
+func eventsCommand(args []string) error {
+	fs := flag.NewFlagSet("events", flag.ContinueOnError)
+	containerID := fs.String("container", "", "only print events from this container")
+	limit := fs.Uint("limit", 0, "print at most this many events (0 for all)")
+	if _, err := parseFlags(fs, args, 0); err != nil {
+		return err
+	}
+
+	conn, err := dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := requestContext()
+	defer cancel()
+
+	resp, err := api.NewStateServiceClient(conn).GetRecentEvents(ctx,
+		&api.GetRecentEventsRequest{
+			ContainerId: *containerID,
+			Limit:       uint32(*limit),
+		})
+	if err != nil {
+		return err
+	}
+
+	out := newPrinter(os.Stdout, config.compact)
+	for _, e := range resp.Events {
+		if err = out.print(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func schemaCommand(args []string) error {
+	fs := flag.NewFlagSet("schema", flag.ContinueOnError)
+	if _, err := parseFlags(fs, args, 0); err != nil {
+		return err
+	}
+
+	conn, err := dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := requestContext()
+	defer cancel()
+
+	resp, err := api.NewTelemetryServiceClient(conn).GetSchema(ctx,
+		&api.GetSchemaRequest{})
+	if err != nil {
+		return err
+	}
+
+	set := &descriptor.FileDescriptorSet{}
+	if err = proto.Unmarshal(resp.FileDescriptorSet, set); err != nil {
+		return err
+	}
+
+	fmt.Printf("schema_version: %d\n", resp.SchemaVersion)
+	for _, fd := range set.File {
+		fmt.Printf("file: %s\n", fd.GetName())
+	}
+	return nil
+}
+
+func healthCommand(args []string) error {
+	fs := flag.NewFlagSet("health", flag.ContinueOnError)
+	if _, err := parseFlags(fs, args, 0); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	conn, err := dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := requestContext()
+	defer cancel()
+
+	// The schema request is cheap and exercises the same service that
+	// subscriptions use.
+	resp, err := api.NewTelemetryServiceClient(conn).GetSchema(ctx,
+		&api.GetSchemaRequest{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("ok: %s schema_version=%d latency=%s\n", config.endpoint,
+		resp.SchemaVersion, time.Since(start))
+	return nil
+}
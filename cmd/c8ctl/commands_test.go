@@ -0,0 +1,108 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+func TestPrinter(t *testing.T) {
+	msg := &api.GetRecentEventsRequest{
+		ContainerId: "c1",
+		Limit:       2,
+	}
+
+	var buf bytes.Buffer
+	if err := newPrinter(&buf, true).print(msg); err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"containerId":"c1","limit":2}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+
+	buf.Reset()
+	if err := newPrinter(&buf, false).print(msg); err != nil {
+		t.Fatal(err)
+	}
+	expected = "{\n  \"containerId\": \"c1\",\n  \"limit\": 2\n}\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestReadSubscription(t *testing.T) {
+	dir, err := ioutil.TempDir("", "c8ctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	write := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	good := write("good.json", `{"eventFilter": {"processEvents": [{"type": "PROCESS_EVENT_TYPE_EXEC"}]}}`)
+	sub, err := readSubscription(good)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(sub.EventFilter.ProcessEvents) != 1 ||
+		sub.EventFilter.ProcessEvents[0].Type != api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC {
+		t.Errorf("Unexpected subscription %+v", sub)
+	}
+
+	for _, name := range []string{
+		write("empty.json", `{}`),
+		write("bad.json", `{"eventFilter": `),
+		write("unknown.json", `{"eventFilters": {}}`),
+		write("syscall.json", `{"eventFilter": {"syscallEvents": [{"type": "SYSCALL_EVENT_TYPE_ENTER", "name": "no_such_syscall"}]}}`),
+		write("sample.json", `{"eventFilter": {"processEvents": [{"type": "PROCESS_EVENT_TYPE_EXEC"}]}, "modifier": {"sample": {"rate": 2}}}`),
+		filepath.Join(dir, "missing.json"),
+	} {
+		if _, err = readSubscription(name); err == nil {
+			t.Errorf("Expected error for %s", filepath.Base(name))
+		}
+	}
+}
+
+func TestParseFlags(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	limit := fs.Uint("limit", 0, "")
+
+	args, err := parseFlags(fs, []string{"-limit", "3", "file"}, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if *limit != 3 || len(args) != 1 || args[0] != "file" {
+		t.Errorf("Unexpected limit %d, args %v", *limit, args)
+	}
+
+	fs = flag.NewFlagSet("test", flag.ContinueOnError)
+	if _, err = parseFlags(fs, []string{"a", "b"}, 1); err == nil {
+		t.Error("Expected error for extra arguments")
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The c8ctl command inspects a running Sensor over its gRPC API. It can
+// check a subscription, tail the events it matches, query the Sensor's
+// process, container and recent event state, and report whether the
+// Sensor is healthy.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+var config struct {
+	endpoint string
+	timeout  time.Duration
+	compact  bool
+}
+
+func init() {
+	flag.StringVar(&config.endpoint, "endpoint",
+		"unix:/var/run/capsule8/sensor.sock",
+		"Capsule8 gRPC API endpoint")
+	flag.DurationVar(&config.timeout, "timeout", 5*time.Second,
+		"timeout for connecting and for requests other than tail")
+	flag.BoolVar(&config.compact, "compact", false,
+		"print one message per line instead of indented JSON")
+
+	flag.Usage = usage
+}
+
+// command is a c8ctl subcommand. Its run function is given the arguments
+// following the command name.
+type command struct {
+	usage string
+	run   func(args []string) error
+}
+
+var commands = map[string]command{
+	"check":      {"check FILE: validate and print a JSON-encoded Subscription", checkCommand},
+	"tail":       {"tail [FILE]: print the events matched by a Subscription (default all process and container events)", tailCommand},
+	"processes":  {"processes [-container ID]: print the processes running on the Node", processesCommand},
+	"containers": {"containers: print the containers known to the Sensor", containersCommand},
+	"events":     {"events [-container ID] [-limit N]: print recently sent events", eventsCommand},
+	"schema":     {"schema: print the Sensor's event schema version and files", schemaCommand},
+	"health":     {"health: check that the Sensor is serving requests", healthCommand},
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [flags] COMMAND [args]\n\nCommands:\n",
+		os.Args[0])
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n", commands[name].usage)
+	}
+
+	fmt.Fprintf(os.Stderr, "\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+// Custom gRPC Dialer that understands "unix:/path/to/sock" as well as TCP addrs
+func dialer(addr string, timeout time.Duration) (net.Conn, error) {
+	var network, address string
+
+	parts := strings.Split(addr, ":")
+	if len(parts) > 1 && parts[0] == "unix" {
+		network = "unix"
+		address = parts[1]
+	} else {
+		network = "tcp"
+		address = addr
+	}
+
+	return net.DialTimeout(network, address, timeout)
+}
+
+func dial() (*grpc.ClientConn, error) {
+	conn, err := grpc.Dial(config.endpoint,
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure(),
+		grpc.WithBlock(),
+		grpc.WithTimeout(config.timeout))
+	if err != nil {
+		return nil, fmt.Errorf("grpc.Dial %s: %s", config.endpoint, err)
+	}
+	return conn, nil
+}
+
+func fatal(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+func main() {
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	if err := cmd.run(flag.Args()[1:]); err != nil {
+		fatal("%s: %s", flag.Arg(0), err)
+	}
+}
@@ -0,0 +1,344 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The loadtest command drives the full telemetry pipeline with synthetic
+// chargen events generated by the Sensor at a fixed rate and reports the
+// throughput and latency seen by the subscriber, without generating any
+// real kernel activity.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	"golang.org/x/net/context"
+
+	"google.golang.org/grpc"
+)
+
+var config struct {
+	endpoint  string
+	rate      uint64
+	length    uint64
+	batchSize uint
+	duration  time.Duration
+	interval  time.Duration
+	json      bool
+}
+
+func init() {
+	flag.StringVar(&config.endpoint, "endpoint",
+		"unix:/var/run/capsule8/sensor.sock",
+		"Capsule8 gRPC API endpoint")
+
+	flag.Uint64Var(&config.rate, "rate", 1000,
+		"events per second to generate (0 for as fast as possible)")
+
+	flag.Uint64Var(&config.length, "length", 64,
+		"payload size of each event in bytes")
+
+	flag.UintVar(&config.batchSize, "batch", 0,
+		"maximum number of events per response (0 to disable batching)")
+
+	flag.DurationVar(&config.duration, "duration", 10*time.Second,
+		"how long to run the test (0 to run until interrupted)")
+
+	flag.DurationVar(&config.interval, "interval", time.Second,
+		"how often to report throughput")
+
+	flag.BoolVar(&config.json, "json", false,
+		"print only the totals, as a JSON object")
+}
+
+// Custom gRPC Dialer that understands "unix:/path/to/sock" as well as TCP addrs
+func dialer(addr string, timeout time.Duration) (net.Conn, error) {
+	var network, address string
+
+	parts := strings.Split(addr, ":")
+	if len(parts) > 1 && parts[0] == "unix" {
+		network = "unix"
+		address = parts[1]
+	} else {
+		network = "tcp"
+		address = addr
+	}
+
+	return net.DialTimeout(network, address, timeout)
+}
+
+func createSubscription() *api.Subscription {
+	sub := &api.Subscription{
+		EventFilter: &api.EventFilter{
+			ChargenEvents: []*api.ChargenEventFilter{
+				&api.ChargenEventFilter{
+					Length: config.length,
+					Rate:   config.rate,
+				},
+			},
+		},
+	}
+
+	if config.duration > 0 {
+		sub.ForDuration = &wrappers.Int64Value{
+			Value: int64(config.duration),
+		}
+	}
+
+	if config.batchSize > 0 {
+		sub.Modifier = &api.Modifier{
+			Batch: &api.BatchModifier{
+				MaxEvents:    int64(config.batchSize),
+				MaxLatencyMs: 100,
+			},
+		}
+	}
+
+	return sub
+}
+
+// The maximum number of event latencies kept for computing percentiles;
+// beyond this, a uniform sample of them is kept.
+const maxLatencySamples = 1000000
+
+// latencies estimates how long events take to reach the subscriber. The
+// Sensor's clock can't be compared with ours, so each event's offset
+// between its Sensor timestamp and the time it was received is measured
+// instead. Latencies are reported relative to the smallest offset seen,
+// i.e. as the delay over the fastest event.
+type latencies struct {
+	start     time.Time
+	offsets   []int64
+	seen      uint64
+	minOffset int64
+	rand      *rand.Rand
+}
+
+func newLatencies(start time.Time) *latencies {
+	return &latencies{
+		start: start,
+		rand:  rand.New(rand.NewSource(start.UnixNano())),
+	}
+}
+
+func (l *latencies) add(e *api.Event, received time.Time) {
+	offset := int64(received.Sub(l.start)) - e.SensorMonotimeNanos
+	if l.seen == 0 || offset < l.minOffset {
+		l.minOffset = offset
+	}
+	l.seen++
+
+	// Reservoir sampling keeps a uniform sample of all offsets
+	if len(l.offsets) < maxLatencySamples {
+		l.offsets = append(l.offsets, offset)
+	} else if i := l.rand.Int63n(int64(l.seen)); i < maxLatencySamples {
+		l.offsets[i] = offset
+	}
+}
+
+// percentiles returns the latencies at each of the given percentiles
+func (l *latencies) percentiles(ps ...float64) []time.Duration {
+	result := make([]time.Duration, len(ps))
+	if len(l.offsets) == 0 {
+		return result
+	}
+
+	sorted := append([]int64(nil), l.offsets...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	for i, p := range ps {
+		n := int(p / 100 * float64(len(sorted)-1))
+		result[i] = time.Duration(sorted[n] - l.minOffset)
+	}
+	return result
+}
+
+// counters accumulates what the subscriber has received
+type counters struct {
+	events    uint64
+	bytes     uint64
+	responses uint64
+
+	// Chargen event indexes advance by the payload length, so a jump
+	// means that events were lost somewhere in the pipeline.
+	lastIndex uint64
+	missing   uint64
+}
+
+func (c *counters) add(resp *api.GetEventsResponse) {
+	c.responses++
+	c.bytes += uint64(proto.Size(resp))
+
+	for _, te := range resp.Events {
+		cg := te.Event.GetChargen()
+		if cg == nil {
+			continue
+		}
+
+		c.events++
+		if c.lastIndex != 0 && cg.Index > c.lastIndex+config.length {
+			c.missing += (cg.Index - c.lastIndex - config.length) /
+				config.length
+		}
+		c.lastIndex = cg.Index
+	}
+}
+
+// results are the totals of a run, as printed with -json
+type results struct {
+	Seconds         float64 `json:"seconds"`
+	Events          uint64  `json:"events"`
+	EventsPerSecond float64 `json:"events_per_second"`
+	MBPerSecond     float64 `json:"mb_per_second"`
+	Missing         uint64  `json:"missing"`
+	Responses       uint64  `json:"responses"`
+	LatencyP50Ms    float64 `json:"latency_p50_ms"`
+	LatencyP90Ms    float64 `json:"latency_p90_ms"`
+	LatencyP99Ms    float64 `json:"latency_p99_ms"`
+	LatencyMaxMs    float64 `json:"latency_max_ms"`
+}
+
+func newResults(c counters, l *latencies, elapsed time.Duration) results {
+	secs := elapsed.Seconds()
+	ps := l.percentiles(50, 90, 99, 100)
+	ms := func(d time.Duration) float64 {
+		return d.Seconds() * 1000
+	}
+
+	return results{
+		Seconds:         secs,
+		Events:          c.events,
+		EventsPerSecond: float64(c.events) / secs,
+		MBPerSecond:     float64(c.bytes) / secs / 1e6,
+		Missing:         c.missing,
+		Responses:       c.responses,
+		LatencyP50Ms:    ms(ps[0]),
+		LatencyP90Ms:    ms(ps[1]),
+		LatencyP99Ms:    ms(ps[2]),
+		LatencyMaxMs:    ms(ps[3]),
+	}
+}
+
+func (r results) report() {
+	fmt.Printf("latency  %8.2f ms p50 %8.2f ms p90 %8.2f ms p99 %8.2f ms max\n",
+		r.LatencyP50Ms, r.LatencyP90Ms, r.LatencyP99Ms, r.LatencyMaxMs)
+}
+
+func (c counters) report(label string, elapsed time.Duration) {
+	secs := elapsed.Seconds()
+	fmt.Printf("%-8s %10.0f events/s %10.2f MB/s %8d events %8d missing %8d responses\n",
+		label, float64(c.events)/secs, float64(c.bytes)/secs/1e6,
+		c.events, c.missing, c.responses)
+}
+
+func main() {
+	flag.Parse()
+
+	conn, err := grpc.Dial(config.endpoint,
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "grpc.Dial: %s\n", err)
+		os.Exit(1)
+	}
+	c := api.NewTelemetryServiceClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := c.GetEvents(ctx, &api.GetEventsRequest{
+		Subscription: createSubscription(),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "GetEvents: %s\n", err)
+		os.Exit(1)
+	}
+
+	// Stop the test early on Control-C
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	// Responses are timestamped as they are received, before any
+	// queueing here.
+	type received struct {
+		resp *api.GetEventsResponse
+		at   time.Time
+	}
+	responses := make(chan received, 1024)
+	go func() {
+		defer close(responses)
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			responses <- received{resp, time.Now()}
+		}
+	}()
+
+	var total, period counters
+	start := time.Now()
+	periodStart := start
+	lat := newLatencies(start)
+
+	ticker := time.NewTicker(config.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case r, ok := <-responses:
+			if !ok {
+				res := newResults(total, lat, time.Since(start))
+				if config.json {
+					json.NewEncoder(os.Stdout).Encode(res)
+				} else {
+					total.report("total", time.Since(start))
+					res.report()
+				}
+				return
+			}
+			if r.resp.Status != nil {
+				fmt.Fprintf(os.Stderr, "status: %s\n",
+					proto.CompactTextString(r.resp.Status))
+			}
+			total.add(r.resp)
+			period.add(r.resp)
+			for _, te := range r.resp.Events {
+				lat.add(te.Event, r.at)
+			}
+
+		case now := <-ticker.C:
+			if !config.json {
+				period.report("period", now.Sub(periodStart))
+			}
+
+			// Carry the index over so that gaps across periods
+			// are counted.
+			period = counters{lastIndex: period.lastIndex}
+			periodStart = now
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+func TestLatencies(t *testing.T) {
+	start := time.Now()
+	l := newLatencies(start)
+
+	if ps := l.percentiles(50); ps[0] != 0 {
+		t.Errorf("Expected zero latency with no events, got %s", ps[0])
+	}
+
+	// The Sensor's clock is an arbitrary 1s behind ours. Event i is
+	// received i milliseconds after the fastest one would have been.
+	for i := 0; i <= 100; i++ {
+		sent := time.Duration(i) * time.Second
+		e := &api.Event{
+			SensorMonotimeNanos: int64(sent - time.Second),
+		}
+		l.add(e, start.Add(sent+time.Duration(i)*time.Millisecond))
+	}
+
+	ps := l.percentiles(0, 50, 99, 100)
+	expected := []time.Duration{
+		0, 50 * time.Millisecond, 99 * time.Millisecond, 100 * time.Millisecond,
+	}
+	for i := range ps {
+		if ps[i] != expected[i] {
+			t.Errorf("Expected percentiles %v, got %v", expected, ps)
+			break
+		}
+	}
+}
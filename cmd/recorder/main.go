@@ -0,0 +1,248 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The recorder command records the events from a telemetry subscription to
+// a file and replays recordings, either to stdout as JSON or to clients of
+// its own gRPC telemetry service.
+//
+//   recorder record -subscription sub.json -o events.rec
+//   recorder replay -speed 2 events.rec
+//   recorder replay -listen 127.0.0.1:8484 events.rec
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/capsule8/capsule8/pkg/recorder"
+	"github.com/golang/protobuf/jsonpb"
+
+	"golang.org/x/net/context"
+
+	"google.golang.org/grpc"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: %s record [flags]\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "       %s replay [flags] recording\n", os.Args[0])
+	os.Exit(2)
+}
+
+func fatal(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+// Custom gRPC Dialer that understands "unix:/path/to/sock" as well as TCP addrs
+func dialer(addr string, timeout time.Duration) (net.Conn, error) {
+	network, address := splitAddress(addr)
+	return net.DialTimeout(network, address, timeout)
+}
+
+func splitAddress(addr string) (string, string) {
+	parts := strings.Split(addr, ":")
+	if len(parts) > 1 && parts[0] == "unix" {
+		return "unix", parts[1]
+	}
+	return "tcp", addr
+}
+
+func record(args []string) {
+	flags := flag.NewFlagSet("record", flag.ExitOnError)
+	endpoint := flags.String("endpoint", "unix:/var/run/capsule8/sensor.sock",
+		"Capsule8 gRPC API endpoint")
+	subFile := flags.String("subscription", "",
+		"file containing the JSON-encoded Subscription to record")
+	output := flags.String("o", "", "file to write the recording to")
+	flags.Parse(args)
+
+	if len(*subFile) == 0 || len(*output) == 0 {
+		fatal("-subscription and -o are required")
+	}
+
+	f, err := os.Open(*subFile)
+	if err != nil {
+		fatal("%s", err)
+	}
+	sub := &api.Subscription{}
+	err = jsonpb.Unmarshal(f, sub)
+	f.Close()
+	if err != nil {
+		fatal("%s: %s", *subFile, err)
+	}
+
+	out, err := os.Create(*output)
+	if err != nil {
+		fatal("%s", err)
+	}
+	defer out.Close()
+
+	w, err := recorder.NewWriter(out)
+	if err != nil {
+		fatal("%s", err)
+	}
+	defer w.Flush()
+
+	conn, err := grpc.Dial(*endpoint,
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure())
+	if err != nil {
+		fatal("grpc.Dial: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := api.NewTelemetryServiceClient(conn).GetEvents(ctx,
+		&api.GetEventsRequest{
+			Subscription: sub,
+		})
+	if err != nil {
+		fatal("GetEvents: %s", err)
+	}
+
+	// Stop recording cleanly on Control-C
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	var n int
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			break
+		}
+
+		now := time.Now()
+		for _, te := range resp.Events {
+			if err = w.Write(now, te.Event); err != nil {
+				fatal("%s: %s", *output, err)
+			}
+			n++
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Recorded %d events\n", n)
+}
+
+// replayServer replays a recording to each client that subscribes to its
+// telemetry service. The recording is replayed in full regardless of the
+// subscription's filters.
+type replayServer struct {
+	path  string
+	speed float64
+}
+
+// GetSchema describes the schema that this build of the recorder was
+// compiled with, which may be newer than that of the recorded events.
+func (rs *replayServer) GetSchema(ctx context.Context, req *api.GetSchemaRequest) (*api.GetSchemaResponse, error) {
+	return api.NewGetSchemaResponse()
+}
+
+func (rs *replayServer) GetEvents(req *api.GetEventsRequest, stream api.TelemetryService_GetEventsServer) error {
+	f, err := os.Open(rs.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r, err := recorder.NewReader(f)
+	if err != nil {
+		return err
+	}
+
+	return recorder.Replay(r, rs.speed, func(e *api.Event) error {
+		return stream.Send(&api.GetEventsResponse{
+			Events: []*api.TelemetryEvent{
+				&api.TelemetryEvent{
+					Event: e,
+				},
+			},
+		})
+	})
+}
+
+func replay(args []string) {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	speed := flags.Float64("speed", 1,
+		"replay speed multiplier (0 to replay as fast as possible)")
+	listen := flags.String("listen", "",
+		"serve the recording on this gRPC API address instead of printing it")
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		usage()
+	}
+	path := flags.Arg(0)
+
+	if len(*listen) > 0 {
+		lis, err := net.Listen(splitAddress(*listen))
+		if err != nil {
+			fatal("%s", err)
+		}
+
+		server := grpc.NewServer()
+		api.RegisterTelemetryServiceServer(server, &replayServer{
+			path:  path,
+			speed: *speed,
+		})
+		fatal("%s", server.Serve(lis))
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		fatal("%s", err)
+	}
+	defer f.Close()
+
+	r, err := recorder.NewReader(f)
+	if err != nil {
+		fatal("%s: %s", path, err)
+	}
+
+	marshaler := &jsonpb.Marshaler{}
+	err = recorder.Replay(r, *speed, func(e *api.Event) error {
+		if err := marshaler.Marshal(os.Stdout, e); err != nil {
+			return err
+		}
+		_, err := io.WriteString(os.Stdout, "\n")
+		return err
+	})
+	if err != nil {
+		fatal("%s: %s", path, err)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "record":
+		record(os.Args[2:])
+	case "replay":
+		replay(os.Args[2:])
+	default:
+		usage()
+	}
+}
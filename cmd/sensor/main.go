@@ -16,16 +16,83 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"os"
+	"strings"
 
+	"github.com/capsule8/capsule8/pkg/config"
 	"github.com/capsule8/capsule8/pkg/sensor"
 	"github.com/capsule8/capsule8/pkg/version"
+	"github.com/golang/glog"
 )
 
+// settings collects the KEY=VALUE configuration overrides given with -set
+type settings []string
+
+func (s *settings) String() string {
+	return strings.Join(*s, " ")
+}
+
+func (s *settings) Set(value string) error {
+	if !strings.Contains(value, "=") {
+		return fmt.Errorf("expected KEY=VALUE")
+	}
+	*s = append(*s, value)
+	return nil
+}
+
+var (
+	configFile  = flag.String("config", "", "read configuration options from `file`")
+	checkConfig = flag.Bool("check-config", false, "validate the configuration and exit")
+	dumpConfig  = flag.Bool("dump-config", false, "print the configuration and exit")
+	overrides   settings
+)
+
+func init() {
+	flag.Var(&overrides, "set", "set configuration option `KEY=VALUE` (may be repeated)")
+}
+
+func loadConfig() error {
+	if len(*configFile) > 0 {
+		err := config.LoadFile(*configFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, o := range overrides {
+		parts := strings.SplitN(o, "=", 2)
+		err := config.Set(parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func main() {
 	// Set "alsologtostderr" flag so that glog messages go stderr as well as /tmp.
 	flag.Set("alsologtostderr", "true")
 	flag.Parse()
 
+	if err := loadConfig(); err != nil {
+		if *checkConfig || *dumpConfig {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		glog.Fatal(err)
+	}
+
+	if *dumpConfig {
+		config.Dump(os.Stdout)
+		return
+	}
+	if *checkConfig {
+		fmt.Println("Configuration OK")
+		return
+	}
+
 	// Log version and build at "Starting ..." for debugging
 	version.InitialBuildLog("sensor")
 
@@ -0,0 +1,183 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/capsule8/capsule8/pkg/cloudevents"
+	"github.com/capsule8/capsule8/pkg/version"
+	"github.com/golang/protobuf/jsonpb"
+)
+
+// Syslog severities (RFC 5424 section 6.2.1)
+const (
+	severityWarning       = 4
+	severityInformational = 6
+)
+
+// Default templates for the message content of each format. CEF templates
+// produce the CEF extension; the CEF header is always generated.
+const (
+	defaultSyslogTemplate = "{{.JSON}}"
+	defaultCEFTemplate    = "rt={{.Millis}} dvchost={{cef .Hostname}} " +
+		"cs1Label=containerId cs1={{cef .Event.ContainerId}} " +
+		"cs2Label=imageId cs2={{cef .Event.ImageId}} " +
+		"cs3Label=processId cs3={{cef .Event.ProcessId}} " +
+		"spid={{.Event.ProcessPid}} externalId={{cef .Event.Id}}"
+)
+
+// templateData is the data available to message templates
+type templateData struct {
+	Event    *api.Event
+	Kind     string
+	Type     string
+	JSON     string
+	Hostname string
+	Time     time.Time
+	Millis   int64
+}
+
+// formatter renders telemetry events as syslog messages
+type formatter struct {
+	cef      bool
+	facility int
+	appName  string
+	hostname string
+	tmpl     *template.Template
+
+	marshaler jsonpb.Marshaler
+}
+
+func newFormatter(format string, facility int, appName, hostname, text string) (*formatter, error) {
+	f := &formatter{
+		facility: facility,
+		appName:  appName,
+		hostname: hostname,
+	}
+
+	switch format {
+	case "rfc5424":
+		if len(text) == 0 {
+			text = defaultSyslogTemplate
+		}
+	case "cef":
+		f.cef = true
+		if len(text) == 0 {
+			text = defaultCEFTemplate
+		}
+	default:
+		return nil, fmt.Errorf("Unknown format %q", format)
+	}
+
+	if facility < 0 || facility > 23 {
+		return nil, fmt.Errorf("Invalid syslog facility %d", facility)
+	}
+
+	tmpl, err := template.New("message").Funcs(template.FuncMap{
+		"cef": cefExtensionEscape,
+	}).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	f.tmpl = tmpl
+
+	return f, nil
+}
+
+// cefHeaderEscape escapes a CEF header field
+func cefHeaderEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "|", `\|`).Replace(s)
+}
+
+// cefExtensionEscape escapes a CEF extension value
+func cefExtensionEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "=", `\=`, "\n", `\n`, "\r", `\r`).Replace(s)
+}
+
+// nilValue returns the RFC 5424 NILVALUE for empty header fields
+func nilValue(s string) string {
+	if len(s) == 0 {
+		return "-"
+	}
+	return s
+}
+
+func (f *formatter) format(e *api.Event, t time.Time) ([]byte, error) {
+	kind, typ := cloudevents.Kind(e)
+
+	js, err := f.marshaler.MarshalToString(e)
+	if err != nil {
+		return nil, err
+	}
+
+	var content bytes.Buffer
+	err = f.tmpl.Execute(&content, templateData{
+		Event:    e,
+		Kind:     kind,
+		Type:     typ,
+		JSON:     js,
+		Hostname: f.hostname,
+		Time:     t,
+		Millis:   t.UnixNano() / int64(time.Millisecond),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	severity := severityInformational
+	if kind == "alert" {
+		severity = severityWarning
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "<%d>1 %s %s %s - %s - ",
+		f.facility*8+severity,
+		t.UTC().Format(time.RFC3339Nano),
+		nilValue(f.hostname),
+		nilValue(f.appName),
+		kind)
+
+	if f.cef {
+		name := strings.TrimSpace(kind + " " + typ)
+		signature := strings.Trim(kind+"."+typ, ".")
+
+		// CEF severities range from 0 to 10
+		cefSeverity := 3
+		if severity == severityWarning {
+			cefSeverity = 8
+		}
+
+		fmt.Fprintf(&msg, "CEF:0|Capsule8|Sensor|%s|%s|%s|%d|",
+			cefHeaderEscape(nilValue(version.Version)),
+			cefHeaderEscape(signature),
+			cefHeaderEscape(name),
+			cefSeverity)
+	}
+	msg.Write(content.Bytes())
+
+	return msg.Bytes(), nil
+}
+
+// frame frames a syslog message for transmission over a stream transport
+// using octet counting (RFC 6587 section 3.4.1)
+func frame(msg []byte) []byte {
+	return append([]byte(fmt.Sprintf("%d ", len(msg))), msg...)
+}
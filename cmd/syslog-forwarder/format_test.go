@@ -0,0 +1,91 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+var testEvent = &api.Event{
+	Id:          "abc",
+	ContainerId: "c=1",
+	Event: &api.Event_Process{
+		Process: &api.ProcessEvent{
+			Type: api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC,
+		},
+	},
+}
+
+var testTime = time.Date(2017, 11, 1, 12, 0, 0, 0, time.UTC)
+
+func TestFormatRFC5424(t *testing.T) {
+	f, err := newFormatter("rfc5424", 16, "capsule8", "host1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg, err := f.format(testEvent, testTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prefix := "<134>1 2017-11-01T12:00:00Z host1 capsule8 - process - {"
+	if !strings.HasPrefix(string(msg), prefix) {
+		t.Errorf("Expected message starting with %q, got %q", prefix, msg)
+	}
+	if !strings.Contains(string(msg), `"id":"abc"`) {
+		t.Errorf("Expected JSON-encoded event in %q", msg)
+	}
+}
+
+func TestFormatCEF(t *testing.T) {
+	f, err := newFormatter("cef", 16, "capsule8", "host1",
+		"cs1={{cef .Event.ContainerId}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg, err := f.format(testEvent, testTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	suffix := "CEF:0|Capsule8|Sensor|-|process.exec|process exec|3|cs1=c\\=1"
+	if !strings.HasSuffix(string(msg), suffix) {
+		t.Errorf("Expected message ending with %q, got %q", suffix, msg)
+	}
+}
+
+func TestFormatterErrors(t *testing.T) {
+	if _, err := newFormatter("xml", 16, "", "", ""); err == nil {
+		t.Error("Expected error for unknown format")
+	}
+	if _, err := newFormatter("cef", 24, "", "", ""); err == nil {
+		t.Error("Expected error for invalid facility")
+	}
+	if _, err := newFormatter("cef", 16, "", "", "{{"); err == nil {
+		t.Error("Expected error for invalid template")
+	}
+}
+
+func TestFrame(t *testing.T) {
+	if s := string(frame([]byte("hello"))); s != "5 hello" {
+		t.Errorf("Expected octet-counted frame, got %q", s)
+	}
+}
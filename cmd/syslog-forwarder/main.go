@@ -0,0 +1,235 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The syslog-forwarder command subscribes to telemetry events from a Sensor
+// and forwards them to a syslog server as RFC 5424 messages, optionally
+// carrying CEF, over TCP or TLS. This lets SIEMs that can't consume the
+// telemetry API directly ingest Capsule8 events.
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/capsule8/capsule8/pkg/services"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/jsonpb"
+
+	"golang.org/x/net/context"
+
+	"google.golang.org/grpc"
+)
+
+var config struct {
+	admin        string
+	endpoint     string
+	subscription string
+	server       string
+	useTLS       bool
+	caFile       string
+	format       string
+	template     string
+	facility     int
+	appName      string
+}
+
+func init() {
+	flag.StringVar(&config.admin, "admin", "",
+		"address to serve /healthz, /metrics and /debug/pprof on")
+	flag.StringVar(&config.endpoint, "endpoint",
+		"unix:/var/run/capsule8/sensor.sock",
+		"Capsule8 gRPC API endpoint")
+	flag.StringVar(&config.subscription, "subscription", "",
+		"file containing the JSON-encoded Subscription to forward")
+	flag.StringVar(&config.server, "server", "",
+		"syslog server address (host:port)")
+	flag.BoolVar(&config.useTLS, "tls", false,
+		"connect to the syslog server using TLS")
+	flag.StringVar(&config.caFile, "tls-ca", "",
+		"PEM file of CA certificates to verify the syslog server with")
+	flag.StringVar(&config.format, "format", "rfc5424",
+		"message format (rfc5424 or cef)")
+	flag.StringVar(&config.template, "template", "",
+		"text/template for message content (the CEF extension for cef)")
+	flag.IntVar(&config.facility, "facility", 16,
+		"syslog facility number (16 is local0)")
+	flag.StringVar(&config.appName, "app-name", "capsule8",
+		"syslog APP-NAME")
+}
+
+// Custom gRPC Dialer that understands "unix:/path/to/sock" as well as TCP addrs
+func dialer(addr string, timeout time.Duration) (net.Conn, error) {
+	var network, address string
+
+	parts := strings.Split(addr, ":")
+	if len(parts) > 1 && parts[0] == "unix" {
+		network = "unix"
+		address = parts[1]
+	} else {
+		network = "tcp"
+		address = addr
+	}
+
+	return net.DialTimeout(network, address, timeout)
+}
+
+func dialSyslog() (net.Conn, error) {
+	if !config.useTLS {
+		return net.DialTimeout("tcp", config.server, 10*time.Second)
+	}
+
+	tlsConfig := &tls.Config{}
+	if len(config.caFile) > 0 {
+		pem, err := ioutil.ReadFile(config.caFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("No certificates found in %s",
+				config.caFile)
+		}
+	}
+
+	return tls.DialWithDialer(&net.Dialer{Timeout: 10 * time.Second},
+		"tcp", config.server, tlsConfig)
+}
+
+// The number of events received from the Sensor, for /metrics
+var eventsReceived uint64
+
+func serveAdmin() {
+	admin := services.NewAdminService(config.admin)
+	admin.AddMetrics(func(mw *services.MetricsWriter) {
+		mw.Counter("capsule8_syslog_forwarder_events_total",
+			"Number of events received for forwarding.",
+			float64(atomic.LoadUint64(&eventsReceived)))
+	})
+	go admin.Serve()
+}
+
+func fatal(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+func main() {
+	flag.Parse()
+
+	if len(config.subscription) == 0 || len(config.server) == 0 {
+		fatal("-subscription and -server are required")
+	}
+
+	f, err := os.Open(config.subscription)
+	if err != nil {
+		fatal("%s", err)
+	}
+	sub := &api.Subscription{}
+	err = jsonpb.Unmarshal(f, sub)
+	f.Close()
+	if err != nil {
+		fatal("%s: %s", config.subscription, err)
+	}
+
+	hostname, _ := os.Hostname()
+	fm, err := newFormatter(config.format, config.facility, config.appName,
+		hostname, config.template)
+	if err != nil {
+		fatal("%s", err)
+	}
+
+	if len(config.admin) > 0 {
+		serveAdmin()
+	}
+
+	conn, err := grpc.Dial(config.endpoint,
+		grpc.WithDialer(dialer),
+		grpc.WithInsecure())
+	if err != nil {
+		fatal("grpc.Dial: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := api.NewTelemetryServiceClient(conn).GetEvents(ctx,
+		&api.GetEventsRequest{
+			Subscription: sub,
+		})
+	if err != nil {
+		fatal("GetEvents: %s", err)
+	}
+
+	// Exit cleanly on Control-C
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	var syslog net.Conn
+	defer func() {
+		if syslog != nil {
+			syslog.Close()
+		}
+	}()
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			glog.Infof("Recv: %s", err)
+			return
+		}
+
+		atomic.AddUint64(&eventsReceived, uint64(len(resp.Events)))
+		for _, te := range resp.Events {
+			msg, err := fm.format(te.Event, time.Now())
+			if err != nil {
+				glog.Warningf("Couldn't format event: %s", err)
+				continue
+			}
+
+			// Reconnect once if the syslog server has gone away;
+			// the event is dropped if that fails too.
+			for attempt := 0; attempt < 2; attempt++ {
+				if syslog == nil {
+					syslog, err = dialSyslog()
+					if err != nil {
+						glog.Warningf("Couldn't connect to %s: %s",
+							config.server, err)
+						break
+					}
+				}
+
+				_, err = syslog.Write(frame(msg))
+				if err == nil {
+					break
+				}
+				glog.Warningf("Write to %s failed: %s",
+					config.server, err)
+				syslog.Close()
+				syslog = nil
+			}
+		}
+	}
+}
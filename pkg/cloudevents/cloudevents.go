@@ -0,0 +1,170 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cloudevents translates telemetry events into CloudEvents 1.0
+// (https://cloudevents.io) envelopes.
+package cloudevents
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/golang/protobuf/jsonpb"
+)
+
+// SpecVersion is the version of the CloudEvents specification implemented
+const SpecVersion = "1.0"
+
+// Content types of CloudEvents in structured mode and of their data
+const (
+	StructuredContentType = "application/cloudevents+json"
+	DataContentType       = "application/json"
+)
+
+// Mapping specifies how the context attributes of a CloudEvent are derived
+// from a telemetry event. Each field is a pattern in which the following
+// placeholders are replaced:
+//
+//   {sensor_id}     the id of the Sensor that generated the event
+//   {container_id}  the id of the container associated with the event
+//   {image_id}      the id of the container's image
+//   {process_id}    the id of the process associated with the event
+//   {kind}          the kind of event (i.e. "process", "network")
+//   {type}          the event's type within its kind (i.e. "exec")
+type Mapping struct {
+	Source  string
+	Type    string
+	Subject string
+}
+
+// DefaultMapping is the Mapping used when no other is configured
+var DefaultMapping = Mapping{
+	Source:  "/capsule8/sensor/{sensor_id}",
+	Type:    "com.capsule8.{kind}.{type}",
+	Subject: "{container_id}",
+}
+
+// Event is a CloudEvent carrying a telemetry event as its data
+type Event struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// New wraps a telemetry event in a CloudEvent using the given Mapping. The
+// time at which the CloudEvent is published is given as t.
+func New(e *api.Event, m Mapping, t time.Time) (*Event, error) {
+	var data bytes.Buffer
+	err := (&jsonpb.Marshaler{}).Marshal(&data, e)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, typ := Kind(e)
+	r := strings.NewReplacer(
+		"{sensor_id}", e.SensorId,
+		"{container_id}", e.ContainerId,
+		"{image_id}", e.ImageId,
+		"{process_id}", e.ProcessId,
+		"{kind}", kind,
+		"{type}", typ,
+	)
+
+	return &Event{
+		SpecVersion:     SpecVersion,
+		ID:              e.Id,
+		Source:          r.Replace(m.Source),
+		Type:            strings.TrimSuffix(r.Replace(m.Type), "."),
+		Subject:         r.Replace(m.Subject),
+		Time:            t.UTC().Format(time.RFC3339Nano),
+		DataContentType: DataContentType,
+		Data:            data.Bytes(),
+	}, nil
+}
+
+// MarshalStructured encodes the CloudEvent in structured content mode.
+func (ce *Event) MarshalStructured() ([]byte, error) {
+	return json.Marshal(ce)
+}
+
+// SetBinary sets the headers of an HTTP message to carry the CloudEvent in
+// binary content mode and returns the message body.
+func (ce *Event) SetBinary(h http.Header) []byte {
+	h.Set("Content-Type", ce.DataContentType)
+	h.Set("ce-specversion", ce.SpecVersion)
+	h.Set("ce-id", ce.ID)
+	h.Set("ce-source", ce.Source)
+	h.Set("ce-type", ce.Type)
+	if len(ce.Subject) > 0 {
+		h.Set("ce-subject", ce.Subject)
+	}
+	if len(ce.Time) > 0 {
+		h.Set("ce-time", ce.Time)
+	}
+
+	return ce.Data
+}
+
+// enumSuffix returns the lower-cased name of an enum value without the
+// prefix shared by all values of the enum.
+func enumSuffix(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
+
+// Kind returns the kind of a telemetry event (i.e. "process") and its type
+// within that kind (i.e. "exec"), as used by the {kind} and {type}
+// placeholders. Kinds without types return an empty type.
+func Kind(e *api.Event) (string, string) {
+	switch ev := e.Event.(type) {
+	case *api.Event_Syscall:
+		return "syscall", enumSuffix(ev.Syscall.Type.String(), "SYSCALL_EVENT_TYPE_")
+	case *api.Event_Process:
+		return "process", enumSuffix(ev.Process.Type.String(), "PROCESS_EVENT_TYPE_")
+	case *api.Event_File:
+		return "file", enumSuffix(ev.File.Type.String(), "FILE_EVENT_TYPE_")
+	case *api.Event_KernelCall:
+		return "kernel_call", ""
+	case *api.Event_Network:
+		return "network", enumSuffix(ev.Network.Type.String(), "NETWORK_EVENT_TYPE_")
+	case *api.Event_KernelLoad:
+		return "kernel_load", enumSuffix(ev.KernelLoad.Type.String(), "KERNEL_LOAD_EVENT_TYPE_")
+	case *api.Event_Privilege:
+		return "privilege", enumSuffix(ev.Privilege.Type.String(), "PRIVILEGE_EVENT_TYPE_")
+	case *api.Event_Memory:
+		return "memory", enumSuffix(ev.Memory.Type.String(), "MEMORY_EVENT_TYPE_")
+	case *api.Event_Container:
+		return "container", enumSuffix(ev.Container.Type.String(), "CONTAINER_EVENT_TYPE_")
+	case *api.Event_Alert:
+		return "alert", ev.Alert.Rule
+	case *api.Event_Metrics:
+		return "metrics", ""
+	case *api.Event_LostEvents:
+		return "lost_events", ""
+	case *api.Event_Chargen:
+		return "chargen", ""
+	case *api.Event_Ticker:
+		return "ticker", ""
+	}
+
+	return "unknown", ""
+}
@@ -0,0 +1,93 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudevents
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+func TestNew(t *testing.T) {
+	e := &api.Event{
+		Id:          "abc",
+		SensorId:    "s1",
+		ContainerId: "c1",
+		Event: &api.Event_Process{
+			Process: &api.ProcessEvent{
+				Type: api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC,
+			},
+		},
+	}
+	now := time.Date(2017, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	ce, err := New(e, DefaultMapping, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ce.ID != "abc" || ce.Source != "/capsule8/sensor/s1" ||
+		ce.Type != "com.capsule8.process.exec" || ce.Subject != "c1" ||
+		ce.Time != "2017-11-01T12:00:00Z" {
+		t.Errorf("Unexpected context attributes %+v", ce)
+	}
+
+	b, err := ce.MarshalStructured()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]interface{}
+	if err = json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded["specversion"] != "1.0" {
+		t.Errorf("Expected specversion 1.0, got %v", decoded["specversion"])
+	}
+	data, ok := decoded["data"].(map[string]interface{})
+	if !ok || data["id"] != "abc" {
+		t.Errorf("Expected event as data, got %v", decoded["data"])
+	}
+
+	h := http.Header{}
+	body := ce.SetBinary(h)
+	if h.Get("ce-type") != "com.capsule8.process.exec" ||
+		h.Get("Content-Type") != DataContentType ||
+		string(body) != string(ce.Data) {
+		t.Errorf("Unexpected binary encoding %v %s", h, body)
+	}
+}
+
+func TestNewUntypedKind(t *testing.T) {
+	e := &api.Event{
+		Id: "abc",
+		Event: &api.Event_Ticker{
+			Ticker: &api.TickerEvent{},
+		},
+	}
+
+	ce, err := New(e, Mapping{
+		Source:  "/test",
+		Type:    "test.{kind}.{type}",
+		Subject: "{process_id}",
+	}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ce.Type != "test.ticker" || len(ce.Subject) != 0 {
+		t.Errorf("Unexpected context attributes %+v", ce)
+	}
+}
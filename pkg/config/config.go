@@ -15,6 +15,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/golang/glog"
 	"github.com/kelseyhightower/envconfig"
 )
@@ -24,7 +26,12 @@ var Global struct {
 	// RunDir is the path to the runtime state directory for Capsule8
 	RunDir string `split_words:"true" default:"/var/run/capsule8"`
 
-	// HTTP address and port for the pprof runtime profiling endpoint.
+	// HTTP address and port for the admin endpoint, which serves
+	// /healthz, Prometheus /metrics and the /debug/pprof runtime
+	// profiling endpoints.
+	AdminAddr string `split_words:"true"`
+
+	// Deprecated; used as AdminAddr if that isn't set.
 	ProfilingAddr string `split_words:"true"`
 }
 
@@ -42,6 +49,18 @@ var Sensor struct {
 	// (i.e. /var/run/docker/libcontainerd)
 	OciContainerDir string `split_words:"true" default:"/var/run/docker/libcontainerd"`
 
+	// ContainerdContainerDir is the paths to the directories used for
+	// containerd's OCI bundle directories in the namespace used by
+	// Kubernetes. The defaults are the directories of containerd's
+	// v2 (io.containerd.runtime.v2.task) and older v1
+	// (io.containerd.runtime.v1.linux) runtimes.
+	ContainerdContainerDir []string `split_words:"true" default:"/run/containerd/io.containerd.runtime.v2.task/k8s.io,/run/containerd/io.containerd.runtime.v1.linux/k8s.io"`
+
+	// CrioContainerDir is the path to the directory used for CRI-O
+	// container storage areas
+	// (i.e. /var/run/containers/storage/overlay-containers)
+	CrioContainerDir string `split_words:"true" default:"/var/run/containers/storage/overlay-containers"`
+
 	// Subscription timeout in seconds
 	SubscriptionTimeout int64 `default:"5"`
 
@@ -51,6 +70,30 @@ var Sensor struct {
 	//   :8484
 	ServerAddr string `split_words:"true" default:"unix:/var/run/capsule8/sensor.sock"`
 
+	// Interval at which the gRPC API Server pings idle clients to check
+	// that they are still alive, and how long to wait for the reply before
+	// closing the connection and cancelling its subscriptions.
+	ClientKeepaliveTime    time.Duration `split_words:"true" default:"30s"`
+	ClientKeepaliveTimeout time.Duration `split_words:"true" default:"10s"`
+
+	// Sensor HTTP/JSON API listen address (i.e. 127.0.0.1:8485). The
+	// HTTP API is disabled if this is empty.
+	HTTPServerAddr string `split_words:"true"`
+
+	// Origins (i.e. https://console.example.com) of the web pages allowed
+	// to subscribe to events over the HTTP API's WebSocket endpoint.
+	// Connections from web pages are refused unless their Origin is
+	// listed.
+	HTTPAllowedOrigins []string `split_words:"true"`
+
+	// Patterns for the source, type and subject attributes of CloudEvents
+	// served by the HTTP API. See cloudevents.Mapping for the available
+	// placeholders. Empty patterns use the defaults in
+	// cloudevents.DefaultMapping.
+	CloudEventsSource  string `split_words:"true"`
+	CloudEventsType    string `split_words:"true"`
+	CloudEventsSubject string `split_words:"true"`
+
 	// Names of cgroups to monitor for events. Each cgroup specified must
 	// exist within the perf_event cgroup hierarchy. For example, if this
 	// is set to "docker", the Sensor will monitor containers for events
@@ -78,15 +121,91 @@ var Sensor struct {
 	// The size of the process info cache. If the system pid_max is greater
 	// than this size, a less performant method of caching will be used.
 	ProcessInfoCacheSize uint `split_words:"true" default:"131072"`
+
+	// The maximum number of processes to include in each event's
+	// process lineage, starting with the process associated with the
+	// event. Process lineage is disabled by default, since it is built
+	// for every event and makes each event larger.
+	ProcessLineageDepth int `split_words:"true"`
+
+	// Attach the Kubernetes pod metadata of each event's container to the
+	// event. The metadata comes from the kubelet's labels on Docker
+	// containers, or from the annotations in containerd's and CRI-O's
+	// OCI bundles. containerd doesn't record the pod's own labels.
+	KubernetesMetadata bool `split_words:"true"`
+
+	// The number of most recently sent events kept for the state API's
+	// GetRecentEvents. Use 0 to disable.
+	RecentEventsBufferSize int `split_words:"true" default:"1024"`
+
+	// How long the Sensor waits on shutdown for subscribers to receive
+	// their final events before closing their connections
+	ShutdownTimeout time.Duration `split_words:"true" default:"10s"`
+
+	// How often the Sensor measures its own resource usage. Intervals
+	// shorter than 100ms are raised to 100ms.
+	SelfMonitorInterval time.Duration `split_words:"true" default:"10s"`
+
+	// The maximum CPU usage of the Sensor, as a percentage of a single
+	// CPU, and its maximum resident memory usage in bytes. When either
+	// budget is exceeded, the kernel events of the event sources named in
+	// ShedSources are disabled until usage falls back under budget. Use 0
+	// to disable a budget.
+	CPUBudget    float64 `envconfig:"cpu_budget"`
+	MemoryBudget uint64  `split_words:"true"`
+
+	// Event sources whose kernel events are disabled when the Sensor is
+	// over budget (any of file, kernel, kernel_load, memory, network,
+	// privilege, process, syscall)
+	ShedSources []string `split_words:"true" default:"syscall,network,kernel"`
+
+	// Regular expressions matched against each command-line argument in
+	// events. A matching argument is redacted or, if the expression has
+	// a parenthesized subexpression, only the text that it matches (i.e.
+	// "^--password=(.*)"). The expressions may not contain commas.
+	RedactArguments []string `split_words:"true"`
+
+	// File paths in events that are under any of these directories are
+	// redacted
+	RedactPathPrefixes []string `split_words:"true"`
+
+	// Redact the values of environment variables in the configurations
+	// reported by container events
+	RedactEnvironment bool `split_words:"true"`
+
+	// How redacted values are replaced: "hash" replaces them with a keyed
+	// hash, so that equal values can still be correlated, and "drop"
+	// removes them
+	RedactMode string `split_words:"true" default:"hash"`
+
+	// The key used to hash redacted values. Without one, short values
+	// can be recovered from their hashes by guessing.
+	RedactHashKey string `split_words:"true"`
 }
 
-func init() {
-	err := envconfig.Process("CAPSULE8", &Global)
-	if err != nil {
-		glog.Fatal(err)
+// specs maps the environment variable prefix of each set of configuration
+// options to the options themselves.
+var specs = []struct {
+	prefix string
+	spec   interface{}
+}{
+	{"CAPSULE8", &Global},
+	{"CAPSULE8_SENSOR", &Sensor},
+}
+
+func process() error {
+	for _, s := range specs {
+		err := envconfig.Process(s.prefix, s.spec)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = envconfig.Process("CAPSULE8_SENSOR", &Sensor)
+	return nil
+}
+
+func init() {
+	err := process()
 	if err != nil {
 		glog.Fatal(err)
 	}
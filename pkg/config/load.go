@@ -0,0 +1,169 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// Configuration options are normally taken from the environment. They may
+// also be read from a configuration file or overridden individually (i.e.
+// from command-line flags). In order of decreasing precedence, the value of
+// an option is taken from:
+//
+//   1. Set
+//   2. The environment
+//   3. LoadFile
+//   4. The default value of the option
+//
+// Options are always named by their environment variable names (i.e.
+// CAPSULE8_SENSOR_SERVER_ADDR), regardless of where they're set.
+
+// Keys returns the sorted names of all configuration options.
+func Keys() ([]string, error) {
+	var buf bytes.Buffer
+	err := usage(&buf, "{{range .}}{{.Key}}\n{{end}}")
+	if err != nil {
+		return nil, err
+	}
+
+	keys := strings.Fields(buf.String())
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// LoadFile reads configuration options from the named file. Each line of
+// the file has the form KEY=VALUE, where KEY is the name of the option.
+// Blank lines and lines starting with '#' are ignored. Options that are
+// already set in the environment are not changed. An error is returned if
+// the file names an unknown option or if any value is invalid.
+func LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	keys, err := Keys()
+	if err != nil {
+		return err
+	}
+	known := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		known[k] = true
+	}
+
+	settings := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("%s:%d: expected KEY=VALUE", path, n)
+		}
+
+		key := strings.TrimSpace(parts[0])
+		if !known[key] {
+			return fmt.Errorf("%s:%d: unknown option %s", path, n, key)
+		}
+		settings[key] = strings.Trim(strings.TrimSpace(parts[1]), `"`)
+	}
+	if err = scanner.Err(); err != nil {
+		return err
+	}
+
+	for key, value := range settings {
+		if _, ok := os.LookupEnv(key); ok {
+			continue
+		}
+		if err = os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+
+	return process()
+}
+
+// Set sets the named configuration option, overriding any value it has
+// from the environment or a configuration file.
+func Set(key, value string) error {
+	keys, err := Keys()
+	if err != nil {
+		return err
+	}
+
+	i := sort.SearchStrings(keys, key)
+	if i == len(keys) || keys[i] != key {
+		return fmt.Errorf("Unknown option %s", key)
+	}
+
+	if err = os.Setenv(key, value); err != nil {
+		return err
+	}
+
+	return process()
+}
+
+// Dump writes the current value of every configuration option to w in the
+// format read by LoadFile.
+func Dump(w io.Writer) error {
+	return usage(w, "{{range .}}{{.Key}}={{value .Field}}\n{{end}}")
+}
+
+func usage(w io.Writer, format string) error {
+	tmpl, err := template.New("config").Funcs(template.FuncMap{
+		"value": formatValue,
+	}).Parse(format)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range specs {
+		err = envconfig.Usaget(s.prefix, s.spec, w, tmpl)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatValue formats a configuration option's value the way envconfig
+// parses it.
+func formatValue(v reflect.Value) string {
+	if v.Kind() == reflect.Slice {
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = fmt.Sprint(v.Index(i).Interface())
+		}
+		return strings.Join(elems, ",")
+	}
+
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,112 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func writeConfigFile(t *testing.T, contents string) string {
+	f, err := ioutil.TempFile("", "capsule8-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if _, err = f.WriteString(contents); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func TestLoadFile(t *testing.T) {
+	// Deferred calls run last first, so the configuration is reloaded
+	// after the variables have been unset.
+	defer process()
+	defer os.Unsetenv("CAPSULE8_SENSOR_SERVER_ADDR")
+	defer os.Unsetenv("CAPSULE8_SENSOR_CHANNEL_BUFFER_LENGTH")
+
+	os.Setenv("CAPSULE8_SENSOR_CHANNEL_BUFFER_LENGTH", "7")
+
+	path := writeConfigFile(t, `
+# Listen on TCP
+CAPSULE8_SENSOR_SERVER_ADDR = "127.0.0.1:8484"
+CAPSULE8_SENSOR_CHANNEL_BUFFER_LENGTH=100
+`)
+	defer os.Remove(path)
+
+	if err := LoadFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if Sensor.ServerAddr != "127.0.0.1:8484" {
+		t.Errorf("Expected server address from file, got %q",
+			Sensor.ServerAddr)
+	}
+	if Sensor.ChannelBufferLength != 7 {
+		t.Errorf("Expected channel buffer length from environment, got %d",
+			Sensor.ChannelBufferLength)
+	}
+
+	if err := Set("CAPSULE8_SENSOR_CHANNEL_BUFFER_LENGTH", "9"); err != nil {
+		t.Fatal(err)
+	}
+	if Sensor.ChannelBufferLength != 9 {
+		t.Errorf("Expected channel buffer length from Set, got %d",
+			Sensor.ChannelBufferLength)
+	}
+}
+
+func TestLoadFileErrors(t *testing.T) {
+	defer process()
+
+	for _, contents := range []string{
+		"CAPSULE8_SENSOR_NO_SUCH_OPTION=1\n",
+		"CAPSULE8_SENSOR_SERVER_ADDR\n",
+		"CAPSULE8_SENSOR_CHANNEL_BUFFER_LENGTH=lots\n",
+	} {
+		path := writeConfigFile(t, contents)
+		if err := LoadFile(path); err == nil {
+			t.Errorf("Expected error loading %q", contents)
+		}
+		os.Remove(path)
+		os.Unsetenv("CAPSULE8_SENSOR_CHANNEL_BUFFER_LENGTH")
+	}
+
+	if err := Set("CAPSULE8_NO_SUCH_OPTION", "1"); err == nil {
+		t.Error("Expected error setting unknown option")
+	}
+}
+
+func TestDump(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Dump(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	dump := buf.String()
+	for _, want := range []string{
+		"CAPSULE8_RUN_DIR=/var/run/capsule8\n",
+		"CAPSULE8_SENSOR_CLIENT_KEEPALIVE_TIME=30s\n",
+		"CAPSULE8_SENSOR_CGROUP_NAME=\n",
+	} {
+		if !strings.Contains(dump, want) {
+			t.Errorf("Expected %q in dump:\n%s", want, dump)
+		}
+	}
+}
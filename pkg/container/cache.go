@@ -35,9 +35,15 @@ type Info struct {
 	Name      string
 	ImageID   string
 	ImageName string
+
+	// Labels set on the container by its runtime, if any
+	Labels map[string]string
+
+	// Kubernetes metadata derived from Labels, once it is known
+	kubernetes *KubernetesInfo
 }
 
-func cacheUpdate(cID string, cName string, iID string, iName string) {
+func cacheUpdate(cID string, cName string, iID string, iName string, labels map[string]string) {
 	// Initialize container cache if this is the first event
 	cacheOnce.Do(func() {
 		cache = make(map[string]*Info)
@@ -52,6 +58,7 @@ func cacheUpdate(cID string, cName string, iID string, iName string) {
 			Name:      cName,
 			ImageID:   iID,
 			ImageName: iName,
+			Labels:    labels,
 		}
 
 		cache[cID] = i
@@ -77,3 +84,16 @@ func GetInfo(containerID string) *Info {
 
 	return cache[containerID]
 }
+
+// List returns copies of the cached information of all containers
+// currently known.
+func List() []Info {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	infos := make([]Info, 0, len(cache))
+	for _, info := range cache {
+		infos = append(infos, *info)
+	}
+	return infos
+}
@@ -100,7 +100,8 @@ type DockerConfigConfig struct {
 	User       string `json:"User"`
 
 	// XXX: ...
-	Image string `json:"Image"`
+	Image  string            `json:"Image"`
+	Labels map[string]string `json:"Labels"`
 	// XXX: ...
 }
 
@@ -135,7 +136,7 @@ func newDockerEventFromConfigData(configV2Json []byte) (*dockerEvent, error) {
 	//
 	// Update container and process info caches
 	//
-	cacheUpdate(config.ID, name, imageID, imageName)
+	cacheUpdate(config.ID, name, imageID, imageName, config.Config.Labels)
 
 	var state dockerContainerState
 
@@ -345,7 +346,7 @@ func updateCaches(containerPath string) {
 	}
 
 	cacheUpdate(configV2.ID, configV2.Name, configV2.Image,
-		configV2.Config.Image)
+		configV2.Config.Image, configV2.Config.Labels)
 }
 
 func initializeDockerSensor() error {
@@ -0,0 +1,200 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+//
+// The kubelet's Docker integration labels each container that it creates
+// with the identity of its pod, and labels each pod's sandbox container
+// with the pod's own labels as well. This is enough to attribute a
+// container to its pod without querying the Kubernetes API server.
+//
+// containerd and CRI-O record the same identity as annotations in each
+// container's OCI bundle instead. These are translated into the labels
+// used by the Docker integration when the bundle is seen. containerd
+// doesn't record the pod's own labels, so pods run by containerd have
+// none.
+//
+
+const (
+	kubernetesLabelPrefix        = "io.kubernetes."
+	kubernetesPodNameLabel       = "io.kubernetes.pod.name"
+	kubernetesPodNamespaceLabel  = "io.kubernetes.pod.namespace"
+	kubernetesPodUIDLabel        = "io.kubernetes.pod.uid"
+	kubernetesContainerNameLabel = "io.kubernetes.container.name"
+	kubernetesSandboxIDLabel     = "io.kubernetes.sandbox.id"
+	kubernetesDockerTypeLabel    = "io.kubernetes.docker.type"
+
+	// Labels the kubelet uses to carry pod annotations
+	kubernetesAnnotationPrefix = "annotation."
+
+	kubernetesSandboxType = "podsandbox"
+
+	// containerd OCI annotations
+	containerdContainerTypeAnnotation = "io.kubernetes.cri.container-type"
+	containerdContainerNameAnnotation = "io.kubernetes.cri.container-name"
+	containerdImageNameAnnotation     = "io.kubernetes.cri.image-name"
+	containerdSandboxIDAnnotation     = "io.kubernetes.cri.sandbox-id"
+	containerdSandboxNameAnnotation   = "io.kubernetes.cri.sandbox-name"
+	containerdSandboxNSAnnotation     = "io.kubernetes.cri.sandbox-namespace"
+	containerdSandboxUIDAnnotation    = "io.kubernetes.cri.sandbox-uid"
+
+	// CRI-O OCI annotations, in addition to the kubelet's pod and
+	// container labels
+	crioContainerTypeAnnotation = "io.kubernetes.cri-o.ContainerType"
+	crioImageNameAnnotation     = "io.kubernetes.cri-o.ImageName"
+	crioLabelsAnnotation        = "io.kubernetes.cri-o.Labels"
+	crioSandboxIDAnnotation     = "io.kubernetes.cri-o.SandboxID"
+
+	// Value of both runtimes' container type annotations for sandboxes
+	ociSandboxType = "sandbox"
+)
+
+// KubernetesInfo describes the Kubernetes pod that a container belongs to.
+// It must not be modified.
+type KubernetesInfo struct {
+	PodName       string
+	PodNamespace  string
+	PodUID        string
+	ContainerName string
+
+	// The pod's labels, if its sandbox container is known
+	PodLabels map[string]string
+}
+
+// podLabels returns the pod labels among the labels of a sandbox container.
+func podLabels(sandboxLabels map[string]string) map[string]string {
+	labels := make(map[string]string)
+	for k, v := range sandboxLabels {
+		if strings.HasPrefix(k, kubernetesLabelPrefix) ||
+			strings.HasPrefix(k, kubernetesAnnotationPrefix) {
+			continue
+		}
+		labels[k] = v
+	}
+	return labels
+}
+
+// ociKubernetesLabels translates the Kubernetes annotations in a container's
+// OCI bundle into the labels the kubelet sets on Docker containers. It
+// returns nil if the container wasn't created for Kubernetes.
+func ociKubernetesLabels(annotations map[string]string) map[string]string {
+	labels := make(map[string]string)
+
+	if t, ok := annotations[containerdContainerTypeAnnotation]; ok {
+		labels[kubernetesDockerTypeLabel] = ociContainerType(t)
+		for label, annotation := range map[string]string{
+			kubernetesPodNameLabel:       containerdSandboxNameAnnotation,
+			kubernetesPodNamespaceLabel:  containerdSandboxNSAnnotation,
+			kubernetesPodUIDLabel:        containerdSandboxUIDAnnotation,
+			kubernetesContainerNameLabel: containerdContainerNameAnnotation,
+			kubernetesSandboxIDLabel:     containerdSandboxIDAnnotation,
+		} {
+			if v, ok := annotations[annotation]; ok {
+				labels[label] = v
+			}
+		}
+	} else if t, ok := annotations[crioContainerTypeAnnotation]; ok {
+		// CRI-O keeps the container's labels, including the pod's
+		// labels for its sandbox, as JSON.
+		json.Unmarshal([]byte(annotations[crioLabelsAnnotation]), &labels)
+
+		labels[kubernetesDockerTypeLabel] = ociContainerType(t)
+		for _, label := range []string{
+			kubernetesPodNameLabel,
+			kubernetesPodNamespaceLabel,
+			kubernetesPodUIDLabel,
+			kubernetesContainerNameLabel,
+		} {
+			if v, ok := annotations[label]; ok {
+				labels[label] = v
+			}
+		}
+		if v, ok := annotations[crioSandboxIDAnnotation]; ok {
+			labels[kubernetesSandboxIDLabel] = v
+		}
+	} else {
+		return nil
+	}
+
+	return labels
+}
+
+// ociImageName returns the image name recorded in a container's OCI bundle
+// annotations, if any.
+func ociImageName(annotations map[string]string) string {
+	if name, ok := annotations[containerdImageNameAnnotation]; ok {
+		return name
+	}
+	return annotations[crioImageNameAnnotation]
+}
+
+func ociContainerType(t string) string {
+	if t == ociSandboxType {
+		return kubernetesSandboxType
+	}
+	return "container"
+}
+
+// kubernetesInfo derives the Kubernetes metadata of a container from its
+// labels and those of its pod's sandbox container, which may be nil if it
+// isn't known.
+func kubernetesInfo(info *Info, sandbox *Info) *KubernetesInfo {
+	namespace, ok := info.Labels[kubernetesPodNamespaceLabel]
+	if !ok {
+		return nil
+	}
+
+	k := &KubernetesInfo{
+		PodName:       info.Labels[kubernetesPodNameLabel],
+		PodNamespace:  namespace,
+		PodUID:        info.Labels[kubernetesPodUIDLabel],
+		ContainerName: info.Labels[kubernetesContainerNameLabel],
+	}
+	if sandbox != nil {
+		k.PodLabels = podLabels(sandbox.Labels)
+	}
+	return k
+}
+
+// GetKubernetesInfo returns the Kubernetes metadata for the container with
+// the given ID, or nil if the container isn't known or wasn't created by
+// the kubelet.
+func GetKubernetesInfo(containerID string) *KubernetesInfo {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	info := cache[containerID]
+	if info == nil {
+		return nil
+	}
+	if info.kubernetes != nil && info.kubernetes.PodLabels != nil {
+		return info.kubernetes
+	}
+
+	// The sandbox is normally created before the pod's other
+	// containers, but keep looking for it until it has been seen.
+	sandbox := info
+	if info.Labels[kubernetesDockerTypeLabel] != kubernetesSandboxType {
+		sandbox = cache[info.Labels[kubernetesSandboxIDLabel]]
+	}
+
+	info.kubernetes = kubernetesInfo(info, sandbox)
+	return info.kubernetes
+}
@@ -0,0 +1,116 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import "testing"
+
+func TestGetKubernetesInfo(t *testing.T) {
+	cacheUpdate("sandbox", "k8s_POD_web", "", "", map[string]string{
+		"app":                                  "web",
+		"io.kubernetes.docker.type":            "podsandbox",
+		"io.kubernetes.pod.name":               "web-1",
+		"io.kubernetes.pod.namespace":          "prod",
+		"annotation.kubernetes.io/config.seen": "now",
+	})
+	cacheUpdate("app", "k8s_nginx_web", "", "", map[string]string{
+		"io.kubernetes.docker.type":    "container",
+		"io.kubernetes.pod.name":       "web-1",
+		"io.kubernetes.pod.namespace":  "prod",
+		"io.kubernetes.pod.uid":        "uid-1",
+		"io.kubernetes.container.name": "nginx",
+		"io.kubernetes.sandbox.id":     "sandbox",
+	})
+	cacheUpdate("plain", "plain", "", "", nil)
+	defer func() {
+		cacheDelete("sandbox")
+		cacheDelete("app")
+		cacheDelete("plain")
+	}()
+
+	k := GetKubernetesInfo("app")
+	if k == nil {
+		t.Fatal("Expected Kubernetes metadata")
+	}
+	if k.PodName != "web-1" || k.PodNamespace != "prod" ||
+		k.PodUID != "uid-1" || k.ContainerName != "nginx" {
+		t.Errorf("Unexpected Kubernetes metadata %+v", k)
+	}
+	if len(k.PodLabels) != 1 || k.PodLabels["app"] != "web" {
+		t.Errorf("Expected pod labels {app: web}, got %v", k.PodLabels)
+	}
+
+	if GetKubernetesInfo("plain") != nil {
+		t.Error("Expected no Kubernetes metadata for a plain container")
+	}
+	if GetKubernetesInfo("unknown") != nil {
+		t.Error("Expected no Kubernetes metadata for an unknown container")
+	}
+}
+
+func TestOciKubernetesLabels(t *testing.T) {
+	if labels := ociKubernetesLabels(map[string]string{"a": "b"}); labels != nil {
+		t.Errorf("Expected no labels without Kubernetes annotations, got %v",
+			labels)
+	}
+
+	containerd := ociKubernetesLabels(map[string]string{
+		"io.kubernetes.cri.container-type":    "container",
+		"io.kubernetes.cri.container-name":    "nginx",
+		"io.kubernetes.cri.sandbox-id":        "sandbox",
+		"io.kubernetes.cri.sandbox-name":      "web-1",
+		"io.kubernetes.cri.sandbox-namespace": "prod",
+		"io.kubernetes.cri.sandbox-uid":       "uid-1",
+	})
+	crioSandbox := ociKubernetesLabels(map[string]string{
+		"io.kubernetes.cri-o.ContainerType": "sandbox",
+		"io.kubernetes.cri-o.Labels":        `{"app":"web","io.kubernetes.pod.name":"web-1"}`,
+		"io.kubernetes.pod.name":            "web-1",
+		"io.kubernetes.pod.namespace":       "prod",
+	})
+	crio := ociKubernetesLabels(map[string]string{
+		"io.kubernetes.cri-o.ContainerType": "container",
+		"io.kubernetes.cri-o.SandboxID":     "crio-sandbox",
+		"io.kubernetes.pod.name":            "web-1",
+		"io.kubernetes.pod.namespace":       "prod",
+		"io.kubernetes.pod.uid":             "uid-1",
+		"io.kubernetes.container.name":      "nginx",
+	})
+
+	cacheUpdate("containerd", "nginx", "", "", containerd)
+	cacheUpdate("crio-sandbox", "", "", "", crioSandbox)
+	cacheUpdate("crio", "nginx", "", "", crio)
+	defer func() {
+		cacheDelete("containerd")
+		cacheDelete("crio-sandbox")
+		cacheDelete("crio")
+	}()
+
+	for _, id := range []string{"containerd", "crio"} {
+		k := GetKubernetesInfo(id)
+		if k == nil {
+			t.Errorf("Expected Kubernetes metadata for %s", id)
+			continue
+		}
+		if k.PodName != "web-1" || k.PodNamespace != "prod" ||
+			k.PodUID != "uid-1" || k.ContainerName != "nginx" {
+			t.Errorf("Unexpected Kubernetes metadata for %s: %+v", id, k)
+		}
+	}
+
+	if k := GetKubernetesInfo("crio"); k != nil &&
+		(len(k.PodLabels) != 1 || k.PodLabels["app"] != "web") {
+		t.Errorf("Expected pod labels {app: web}, got %v", k.PodLabels)
+	}
+}
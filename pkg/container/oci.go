@@ -19,7 +19,10 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"sync"
 
 	"golang.org/x/sys/unix"
@@ -27,6 +30,7 @@ import (
 	"github.com/capsule8/capsule8/pkg/config"
 	"github.com/capsule8/capsule8/pkg/stream"
 	"github.com/capsule8/capsule8/pkg/sys/inotify"
+	"github.com/golang/glog"
 )
 
 //
@@ -51,16 +55,93 @@ type ociEvent struct {
 	State       ociState
 	CgroupsPath string
 	ConfigJSON  string
+
+	// Set when the container runtime has no other event source that
+	// reports container exits (i.e. anything other than Docker).
+	ReportsStop bool
 }
 
 // ----------------------------------------------------------------------------
-// OCI configuration file format
+// OCI container runtimes
 // ----------------------------------------------------------------------------
 
+// ociRuntime describes where a container runtime keeps the OCI bundle
+// directories (containing config.json) for its containers.
+type ociRuntime struct {
+	name string
+
+	// dir is the directory containing one subdirectory per container,
+	// named by container ID.
+	dir string
+
+	// bundleDir is the path of the bundle directory relative to each
+	// container's directory, or empty if they are the same.
+	bundleDir string
+
+	// reportsStop is true if config.json deletion should be reported
+	// as the container stopping.
+	reportsStop bool
+}
+
 func getOciContainerDir() string {
 	return config.Sensor.OciContainerDir
 }
 
+func getOciRuntimes() []ociRuntime {
+	runtimes := []ociRuntime{
+		// Docker's exit events are reported by the Docker sensor.
+		{
+			name: "docker",
+			dir:  getOciContainerDir(),
+		},
+		{
+			name:        "cri-o",
+			dir:         config.Sensor.CrioContainerDir,
+			bundleDir:   "userdata",
+			reportsStop: true,
+		},
+	}
+
+	for _, dir := range config.Sensor.ContainerdContainerDir {
+		runtimes = append(runtimes, ociRuntime{
+			name:        "containerd",
+			dir:         dir,
+			reportsStop: true,
+		})
+	}
+
+	return runtimes
+}
+
+// ociContainerID returns the ID of the container whose config.json is at
+// configPath.
+func ociContainerID(configPath string) string {
+	dir := filepath.Dir(configPath)
+	for _, r := range getOciRuntimes() {
+		if len(r.bundleDir) > 0 && filepath.Base(dir) == r.bundleDir &&
+			strings.HasPrefix(dir, r.dir+"/") {
+			dir = filepath.Dir(dir)
+			break
+		}
+	}
+
+	return filepath.Base(dir)
+}
+
+func ociReportsStop(configPath string) bool {
+	for _, r := range getOciRuntimes() {
+		if strings.HasPrefix(configPath, r.dir+"/") {
+			return r.reportsStop
+		}
+	}
+
+	return false
+}
+
+// ----------------------------------------------------------------------------
+// OCI configuration file format
+// ----------------------------------------------------------------------------
+
 type ociConfigJSON struct {
 	OciVersion string `json:"ociVersion"`
 	Root       struct {
@@ -69,6 +150,7 @@ type ociConfigJSON struct {
 	Linux struct {
 		CgroupsPath string `json:"cgroupsPath"`
 	} `json:"linux"`
+	Annotations map[string]string `json:"annotations"`
 }
 
 // ----------------------------------------------------------------------------
@@ -89,13 +171,23 @@ func onOciConfigUpdate(configPath string) (*ociEvent, error) {
 	configJSON := ociConfigJSON{}
 	json.Unmarshal(data, &configJSON)
 
-	containerID := filepath.Base(filepath.Dir(configPath))
+	containerID := ociContainerID(configPath)
 
 	ev := &ociEvent{
 		ID:          containerID,
+		Image:       ociImageName(configJSON.Annotations),
 		State:       ociRunning,
 		CgroupsPath: configJSON.Linux.CgroupsPath,
 		ConfigJSON:  string(data),
+		ReportsStop: ociReportsStop(configPath),
+	}
+
+	// Docker's containers are cached by the Docker sensor with their
+	// labels; other runtimes only describe them in their bundles.
+	if ev.ReportsStop {
+		labels := ociKubernetesLabels(configJSON.Annotations)
+		cacheUpdate(ev.ID, labels[kubernetesContainerNameLabel], "",
+			ev.Image, labels)
 	}
 
 	return ev, nil
@@ -105,11 +197,16 @@ func onOciConfigDelete(configPath string) (*ociEvent, error) {
 	//
 	// Look for deletion of config.json to identify container stopped events.
 	//
-	containerID := filepath.Base(filepath.Dir(configPath))
+	containerID := ociContainerID(configPath)
 
 	ev := &ociEvent{
-		ID:    containerID,
-		State: ociStopped,
+		ID:          containerID,
+		State:       ociStopped,
+		ReportsStop: ociReportsStop(configPath),
+	}
+
+	if ev.ReportsStop {
+		cacheDelete(ev.ID)
 	}
 
 	return ev, nil
@@ -130,6 +227,53 @@ func (o *oci) onInotifyEvent(iev *inotify.Event) *ociEvent {
 	return nil
 }
 
+// addBundleWatches is like addWatches, but for runtimes that keep each
+// container's config.json in a subdirectory of the container's directory.
+func addBundleWatches(dir string, bundleDir string, in *inotify.Instance) error {
+	dirMask := uint32((unix.IN_ONLYDIR | unix.IN_CREATE | unix.IN_DELETE))
+	cMask := uint32(unix.IN_DELETE | unix.IN_MOVED_TO | unix.IN_CLOSE_WRITE)
+
+	containerPattern := filepath.Join(dir, "[[:xdigit:]]{64}$")
+	bundlePattern := filepath.Join(dir, "[[:xdigit:]]{64}", bundleDir+"$")
+	re, err := regexp.Compile(bundlePattern)
+	if err != nil {
+		return err
+	}
+
+	walkFn := func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if re.MatchString(path) {
+			err = in.AddWatch(path, cMask)
+			if err != nil {
+				return err
+			}
+			return filepath.SkipDir
+		}
+
+		return nil
+	}
+
+	err = in.AddWatch(dir, dirMask)
+	if err != nil {
+		return err
+	}
+
+	err = in.AddTrigger(containerPattern, dirMask)
+	if err != nil {
+		return err
+	}
+
+	err = in.AddTrigger(bundlePattern, cMask)
+	if err != nil {
+		return err
+	}
+
+	return filepath.Walk(dir, walkFn)
+}
+
 // -----------------------------------------------------------------------------
 // inotify-based OCI sensor
 // -----------------------------------------------------------------------------
@@ -234,7 +378,24 @@ func initializeOciSensor() error {
 
 		o.repeater = stream.NewRepeater(o.eventStream)
 
-		addWatches(getOciContainerDir(), o.inotify)
+		for _, r := range getOciRuntimes() {
+			if _, err := os.Stat(r.dir); err != nil {
+				continue
+			}
+
+			glog.V(1).Infof("Watching %s container runtime state in %s",
+				r.name, r.dir)
+			var err error
+			if len(r.bundleDir) > 0 {
+				err = addBundleWatches(r.dir, r.bundleDir, o.inotify)
+			} else {
+				err = addWatches(r.dir, o.inotify)
+			}
+			if err != nil {
+				glog.Warningf("Couldn't watch %s container runtime state in %s: %s",
+					r.name, r.dir, err)
+			}
+		}
 
 		for {
 			var ok bool
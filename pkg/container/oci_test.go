@@ -0,0 +1,152 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/capsule8/capsule8/pkg/config"
+)
+
+const testOciContainerID = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+// setTestOciDirs points the OCI runtime directories into a temporary
+// directory, returning a function that restores them.
+func setTestOciDirs(t *testing.T) (string, func()) {
+	root, err := ioutil.TempDir("", "capsule8-oci")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oci := config.Sensor.OciContainerDir
+	containerd := config.Sensor.ContainerdContainerDir
+	crio := config.Sensor.CrioContainerDir
+
+	config.Sensor.OciContainerDir = filepath.Join(root, "docker")
+	config.Sensor.ContainerdContainerDir = []string{
+		filepath.Join(root, "v2"),
+		filepath.Join(root, "v1"),
+	}
+	config.Sensor.CrioContainerDir = filepath.Join(root, "crio")
+
+	return root, func() {
+		config.Sensor.OciContainerDir = oci
+		config.Sensor.ContainerdContainerDir = containerd
+		config.Sensor.CrioContainerDir = crio
+		os.RemoveAll(root)
+	}
+}
+
+func writeOciConfig(t *testing.T, path string, contents string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOciContainerID(t *testing.T) {
+	root, restore := setTestOciDirs(t)
+	defer restore()
+
+	tests := []struct {
+		path        string
+		reportsStop bool
+	}{
+		{filepath.Join(root, "docker", testOciContainerID, "config.json"), false},
+		{filepath.Join(root, "v2", testOciContainerID, "config.json"), true},
+		{filepath.Join(root, "v1", testOciContainerID, "config.json"), true},
+		{filepath.Join(root, "crio", testOciContainerID, "userdata", "config.json"), true},
+	}
+	for _, tc := range tests {
+		if id := ociContainerID(tc.path); id != testOciContainerID {
+			t.Errorf("%s: expected container ID %s, got %s", tc.path,
+				testOciContainerID, id)
+		}
+		if r := ociReportsStop(tc.path); r != tc.reportsStop {
+			t.Errorf("%s: expected reportsStop %v, got %v", tc.path,
+				tc.reportsStop, r)
+		}
+	}
+}
+
+func TestOnOciConfigUpdate(t *testing.T) {
+	root, restore := setTestOciDirs(t)
+	defer restore()
+
+	path := filepath.Join(root, "v2", testOciContainerID, "config.json")
+	writeOciConfig(t, path, `{
+		"ociVersion": "1.0.2",
+		"linux": {"cgroupsPath": "/kubepods/pod1/`+testOciContainerID+`"},
+		"annotations": {
+			"io.kubernetes.cri.container-type": "container",
+			"io.kubernetes.cri.container-name": "nginx",
+			"io.kubernetes.cri.image-name": "docker.io/library/nginx:latest",
+			"io.kubernetes.cri.sandbox-name": "web-1",
+			"io.kubernetes.cri.sandbox-namespace": "prod"
+		}
+	}`)
+
+	ev, err := onOciConfigUpdate(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.ID != testOciContainerID || ev.State != ociRunning ||
+		!ev.ReportsStop || ev.Image != "docker.io/library/nginx:latest" ||
+		ev.CgroupsPath != "/kubepods/pod1/"+testOciContainerID {
+		t.Errorf("Unexpected OCI event %+v", ev)
+	}
+
+	info := GetInfo(testOciContainerID)
+	if info == nil {
+		t.Fatal("Expected containerd container to be cached")
+	}
+	if info.Name != "nginx" || info.ImageName != ev.Image {
+		t.Errorf("Unexpected container info %+v", info)
+	}
+	if k := GetKubernetesInfo(testOciContainerID); k == nil ||
+		k.PodName != "web-1" || k.PodNamespace != "prod" {
+		t.Errorf("Unexpected Kubernetes metadata %+v", k)
+	}
+
+	ev, err = onOciConfigDelete(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.State != ociStopped || !ev.ReportsStop {
+		t.Errorf("Unexpected OCI event %+v", ev)
+	}
+	if GetInfo(testOciContainerID) != nil {
+		t.Error("Expected deleted container to be removed from the cache")
+	}
+
+	// Docker's containers are cached by the Docker sensor
+	path = filepath.Join(root, "docker", testOciContainerID, "config.json")
+	writeOciConfig(t, path, `{"ociVersion": "1.0.0"}`)
+	if ev, err = onOciConfigUpdate(path); err != nil || ev.ReportsStop {
+		t.Errorf("Unexpected OCI event %+v (%v)", ev, err)
+	}
+	if GetInfo(testOciContainerID) != nil {
+		t.Error("Expected Docker container not to be cached from its bundle")
+	}
+
+	if _, err = onOciConfigUpdate(filepath.Join(root, "missing.json")); err == nil {
+		t.Error("Expected error for missing config.json")
+	}
+}
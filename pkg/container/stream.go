@@ -113,6 +113,11 @@ func processEvents(e interface{}) interface{} {
 				OciConfig: e.ConfigJSON,
 			}
 
+		} else if e.State == ociStopped && e.ReportsStop {
+			ev = &Event{
+				ID:    e.ID,
+				State: ContainerStopped,
+			}
 		}
 	}
 
@@ -114,3 +114,28 @@ func TestExpressionEvaluation(t *testing.T) {
 			Equal(Identifier("address"), Value("127.0.0.1"))))
 	testEvaluateExpr(t, expr, types, values, true)
 }
+
+func BenchmarkEvaluate(b *testing.B) {
+	types := FieldTypeMap{
+		"port":     api.ValueType_UINT16,
+		"filename": api.ValueType_STRING,
+	}
+	values := FieldValueMap{
+		"port":     uint16(80),
+		"filename": "/etc/passwd",
+	}
+
+	expr, err := NewExpression(LogicalAnd(
+		Equal(Identifier("port"), Value(uint16(80))),
+		Like(Identifier("filename"), Value("/etc/*"))))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err = expr.Evaluate(types, values); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -143,6 +143,15 @@ func expressionAsKernelFilterString(expr *api.Expression) string {
 		operands := expr.GetBinaryOp()
 		lhs := expressionAsKernelFilterString(operands.Lhs)
 		rhs := expressionAsKernelFilterString(operands.Rhs)
+		// A left operand using the other logical operator must be
+		// parenthesized, or the kernel's precedence rules would bind
+		// the && to its last term instead.
+		if lt := operands.Lhs.GetType(); lt != t &&
+			(lt == api.Expression_LOGICAL_AND ||
+				lt == api.Expression_LOGICAL_OR) {
+
+			lhs = fmt.Sprintf("(%s)", lhs)
+		}
 		if operands.Rhs.GetType() == api.Expression_LOGICAL_AND ||
 			operands.Rhs.GetType() == api.Expression_LOGICAL_OR {
 
@@ -124,4 +124,20 @@ func TestExpressionStrings(t *testing.T) {
 		"port = 80 AND (address = \"192.168.1.4\" OR address = \"127.0.0.1\")")
 	testExpressionAsKernelFilterString(t, expr,
 		"port == 80 && (address == \"192.168.1.4\" || address == \"127.0.0.1\")")
+
+	expr = LogicalAnd(
+		LogicalOr(
+			Equal(Identifier("port"), Value(uint16(80))),
+			Equal(Identifier("port"), Value(uint16(443)))),
+		Equal(Identifier("address"), Value("127.0.0.1")))
+	testExpressionAsKernelFilterString(t, expr,
+		"(port == 80 || port == 443) && address == \"127.0.0.1\"")
+
+	expr = LogicalOr(
+		LogicalOr(
+			Equal(Identifier("port"), Value(uint16(80))),
+			Equal(Identifier("port"), Value(uint16(443)))),
+		Equal(Identifier("port"), Value(uint16(8080))))
+	testExpressionAsKernelFilterString(t, expr,
+		"port == 80 || port == 443 || port == 8080")
 }
@@ -0,0 +1,184 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package recorder records telemetry events to files and replays them.
+//
+// A recording starts with the 8-byte magic string "C8REC\x00\x00\x01" and is
+// followed by one record per event. Each record is the time at which the
+// event was recorded (int64 nanoseconds since the Unix epoch), the length of
+// the encoded event (uint32) and the event encoded as an api.Event protobuf.
+// All integers are little-endian.
+package recorder
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/golang/protobuf/proto"
+)
+
+const magic = "C8REC\x00\x00\x01"
+
+// maxEventSize limits the size of events read from a recording so that a
+// corrupt length can't cause an enormous allocation.
+const maxEventSize = 16 << 20
+
+// ErrBadMagic is returned by NewReader when its input isn't a recording.
+var ErrBadMagic = errors.New("not a telemetry event recording")
+
+type recordHeader struct {
+	Time   int64
+	Length uint32
+}
+
+// Writer writes events to a recording.
+type Writer struct {
+	w   *bufio.Writer
+	buf *proto.Buffer
+}
+
+// NewWriter creates a new Writer that writes a recording to w.
+func NewWriter(w io.Writer) (*Writer, error) {
+	rw := &Writer{
+		w:   bufio.NewWriter(w),
+		buf: proto.NewBuffer(nil),
+	}
+
+	if _, err := rw.w.WriteString(magic); err != nil {
+		return nil, err
+	}
+
+	return rw, nil
+}
+
+// Write records an event as having been received at time t.
+func (rw *Writer) Write(t time.Time, e *api.Event) error {
+	rw.buf.Reset()
+	if err := rw.buf.Marshal(e); err != nil {
+		return err
+	}
+
+	hdr := recordHeader{
+		Time:   t.UnixNano(),
+		Length: uint32(len(rw.buf.Bytes())),
+	}
+	if err := binary.Write(rw.w, binary.LittleEndian, hdr); err != nil {
+		return err
+	}
+
+	_, err := rw.w.Write(rw.buf.Bytes())
+	return err
+}
+
+// Flush writes any buffered records to the underlying io.Writer.
+func (rw *Writer) Flush() error {
+	return rw.w.Flush()
+}
+
+// Reader reads events from a recording.
+type Reader struct {
+	r   *bufio.Reader
+	buf []byte
+}
+
+// NewReader creates a new Reader that reads a recording from r.
+func NewReader(r io.Reader) (*Reader, error) {
+	rr := &Reader{
+		r: bufio.NewReader(r),
+	}
+
+	m := make([]byte, len(magic))
+	if _, err := io.ReadFull(rr.r, m); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, ErrBadMagic
+		}
+		return nil, err
+	}
+	if string(m) != magic {
+		return nil, ErrBadMagic
+	}
+
+	return rr, nil
+}
+
+// Next returns the next event in the recording and the time at which it was
+// recorded. At the end of the recording, Next returns io.EOF.
+func (rr *Reader) Next() (time.Time, *api.Event, error) {
+	var hdr recordHeader
+	if err := binary.Read(rr.r, binary.LittleEndian, &hdr); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = errors.New("truncated record header")
+		}
+		return time.Time{}, nil, err
+	}
+	if hdr.Length > maxEventSize {
+		return time.Time{}, nil, errors.New("record is too large")
+	}
+
+	if cap(rr.buf) < int(hdr.Length) {
+		rr.buf = make([]byte, hdr.Length)
+	}
+	rr.buf = rr.buf[:hdr.Length]
+	if _, err := io.ReadFull(rr.r, rr.buf); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			err = errors.New("truncated record")
+		}
+		return time.Time{}, nil, err
+	}
+
+	e := &api.Event{}
+	if err := proto.Unmarshal(rr.buf, e); err != nil {
+		return time.Time{}, nil, err
+	}
+
+	return time.Unix(0, hdr.Time), e, nil
+}
+
+// Replay reads all of the events in a recording and calls fn for each one.
+// The time between calls preserves the relative timing of the events in the
+// recording, divided by speed; i.e. a speed of 2 replays the recording twice
+// as fast as it was recorded. If speed is zero, the events are replayed as
+// quickly as possible. Replay stops at the end of the recording or when fn
+// returns an error, which Replay then returns.
+func Replay(rr *Reader, speed float64, fn func(*api.Event) error) error {
+	var first time.Time
+	start := time.Now()
+
+	for {
+		t, e, err := rr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if first.IsZero() {
+			first = t
+		}
+		if speed > 0 {
+			offset := time.Duration(float64(t.Sub(first)) / speed)
+			if d := time.Until(start.Add(offset)); d > 0 {
+				time.Sleep(d)
+			}
+		}
+
+		if err = fn(e); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+func writeRecording(t *testing.T, start time.Time, n int, gap time.Duration) *bytes.Buffer {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < n; i++ {
+		e := &api.Event{
+			Id: string('a' + byte(i)),
+			Event: &api.Event_Ticker{
+				Ticker: &api.TickerEvent{
+					Seconds: int64(i),
+				},
+			},
+		}
+		if err = w.Write(start.Add(time.Duration(i)*gap), e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	return &buf
+}
+
+func TestRecordAndRead(t *testing.T) {
+	start := time.Unix(1500000000, 0)
+	buf := writeRecording(t, start, 3, time.Second)
+
+	r, err := NewReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		ts, e, err := r.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ts.Equal(start.Add(time.Duration(i) * time.Second)) {
+			t.Errorf("Event %d: unexpected time %s", i, ts)
+		}
+		if e.Id != string('a'+byte(i)) || e.GetTicker().Seconds != int64(i) {
+			t.Errorf("Event %d: unexpected event %+v", i, e)
+		}
+	}
+
+	if _, _, err = r.Next(); err != io.EOF {
+		t.Errorf("Expected io.EOF at end of recording, got %v", err)
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	if _, err := NewReader(bytes.NewBufferString("not a recording")); err != ErrBadMagic {
+		t.Errorf("Expected ErrBadMagic, got %v", err)
+	}
+
+	buf := writeRecording(t, time.Now(), 1, 0)
+	buf.Truncate(buf.Len() - 1)
+	r, err := NewReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = r.Next(); err == nil || err == io.EOF {
+		t.Errorf("Expected error for truncated record, got %v", err)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	buf := writeRecording(t, time.Now(), 3, 100*time.Millisecond)
+	r, err := NewReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// At 10x speed, 200ms of recording takes about 20ms to replay
+	var ids []string
+	start := time.Now()
+	err = Replay(r, 10, func(e *api.Event) error {
+		ids = append(ids, e.Id)
+		return nil
+	})
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 3 || ids[0] != "a" || ids[2] != "c" {
+		t.Errorf("Unexpected replayed events %v", ids)
+	}
+	if elapsed < 15*time.Millisecond || elapsed > time.Second {
+		t.Errorf("Expected replay to take about 20ms, took %s", elapsed)
+	}
+
+	buf = writeRecording(t, time.Now(), 3, 0)
+	r, _ = NewReader(buf)
+	stop := errors.New("stop")
+	err = Replay(r, 0, func(e *api.Event) error {
+		return stop
+	})
+	if err != stop {
+		t.Errorf("Expected Replay to return the callback's error, got %v", err)
+	}
+}
@@ -0,0 +1,303 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//
+// This file implements a small set of built-in detection rules. Each rule
+// inspects a stream of events that the sensor subscribes to internally and
+// emits AlertEvents when it observes the pattern it looks for.
+//
+// glog levels used:
+//   2 = alerts raised
+//
+
+package sensor
+
+import (
+	"fmt"
+	"path/filepath"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/stream"
+	"github.com/golang/glog"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// Maximum number of processes to examine when looking for ancestors
+	alertLineageDepth = 16
+
+	// How long a sensitive file write is remembered while waiting for
+	// a subsequent exec, in nanoseconds
+	alertCorrelationWindow = int64(60 * 1000 * 1000 * 1000)
+)
+
+var alertShells = map[string]bool{
+	"ash":  true,
+	"bash": true,
+	"csh":  true,
+	"dash": true,
+	"ksh":  true,
+	"sh":   true,
+	"tcsh": true,
+	"zsh":  true,
+}
+
+var alertWebServers = map[string]bool{
+	"apache2":  true,
+	"caddy":    true,
+	"httpd":    true,
+	"lighttpd": true,
+	"nginx":    true,
+	"php-fpm":  true,
+}
+
+var alertSensitiveFiles = []string{
+	"/etc/passwd",
+	"/etc/shadow",
+	"/etc/sudoers",
+}
+
+// alertRule is implemented by each built-in detection rule.
+type alertRule interface {
+	// name returns the name used to select the rule in an
+	// AlertEventFilter.
+	name() string
+
+	// eventFilter returns the events that the rule needs to inspect.
+	eventFilter() *api.EventFilter
+
+	// inspect examines an event and returns an alert if the event
+	// completes the rule's pattern.
+	inspect(ev *api.Event) *api.AlertEvent
+}
+
+//
+// web-server-shell: a shell is exec'd by a descendant of a web server
+//
+
+type webServerShellRule struct {
+	sensor *Sensor
+}
+
+func (r *webServerShellRule) name() string {
+	return "web-server-shell"
+}
+
+func (r *webServerShellRule) eventFilter() *api.EventFilter {
+	return &api.EventFilter{
+		ProcessEvents: []*api.ProcessEventFilter{
+			&api.ProcessEventFilter{
+				Type: api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC,
+			},
+		},
+	}
+}
+
+func (r *webServerShellRule) inspect(ev *api.Event) *api.AlertEvent {
+	pe := ev.GetProcess()
+	if pe == nil || pe.Type != api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC {
+		return nil
+	}
+	if !alertShells[filepath.Base(pe.ExecFilename)] {
+		return nil
+	}
+
+	// The process info cache records each task's comm at fork time, so
+	// the exec'ing process itself still carries the name of the process
+	// it was forked from and is included in the search.
+	lineage := ev.ProcessLineage
+	if len(lineage) == 0 {
+		lineage = r.sensor.processCache.ProcessLineage(
+			int(ev.ProcessPid), alertLineageDepth)
+	}
+	for _, p := range lineage {
+		if alertWebServers[p.Command] {
+			return &api.AlertEvent{
+				Rule: r.name(),
+				Description: fmt.Sprintf("Shell %s executed by web server %s (pid %d)",
+					pe.ExecFilename, p.Command, p.Pid),
+				EventIds: []string{ev.Id},
+			}
+		}
+	}
+
+	return nil
+}
+
+//
+// sensitive-file-write-exec: a process opens a sensitive file for writing
+// and then it or one of its descendants execs a program
+//
+
+type sensitiveWriteExecRule struct {
+	sensor *Sensor
+
+	// Pending writes keyed by process ID
+	writes map[string]*api.Event
+}
+
+func (r *sensitiveWriteExecRule) name() string {
+	return "sensitive-file-write-exec"
+}
+
+func (r *sensitiveWriteExecRule) eventFilter() *api.EventFilter {
+	var filenames *api.Expression
+	for _, f := range alertSensitiveFiles {
+		filenames = expression.LogicalOr(filenames, expression.Equal(
+			expression.Identifier("filename"),
+			expression.Value(f)))
+	}
+
+	return &api.EventFilter{
+		FileEvents: []*api.FileEventFilter{
+			&api.FileEventFilter{
+				Type:             api.FileEventType_FILE_EVENT_TYPE_OPEN,
+				FilterExpression: filenames,
+			},
+		},
+		ProcessEvents: []*api.ProcessEventFilter{
+			&api.ProcessEventFilter{
+				Type: api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC,
+			},
+		},
+	}
+}
+
+func (r *sensitiveWriteExecRule) inspect(ev *api.Event) *api.AlertEvent {
+	for id, w := range r.writes {
+		if ev.SensorMonotimeNanos-w.SensorMonotimeNanos > alertCorrelationWindow {
+			delete(r.writes, id)
+		}
+	}
+
+	if fe := ev.GetFile(); fe != nil {
+		if fe.Type == api.FileEventType_FILE_EVENT_TYPE_OPEN &&
+			fe.OpenFlags&(unix.O_WRONLY|unix.O_RDWR) != 0 &&
+			len(ev.ProcessId) > 0 {
+			r.writes[ev.ProcessId] = ev
+		}
+		return nil
+	}
+
+	pe := ev.GetProcess()
+	if pe == nil || pe.Type != api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC ||
+		len(r.writes) == 0 {
+		return nil
+	}
+
+	lineage := ev.ProcessLineage
+	if len(lineage) == 0 {
+		lineage = r.sensor.processCache.ProcessLineage(
+			int(ev.ProcessPid), alertLineageDepth)
+	}
+	for _, p := range lineage {
+		w, ok := r.writes[p.ProcessId]
+		if !ok {
+			continue
+		}
+
+		delete(r.writes, p.ProcessId)
+		return &api.AlertEvent{
+			Rule: r.name(),
+			Description: fmt.Sprintf("%s executed after %s was opened for writing",
+				pe.ExecFilename, w.GetFile().Filename),
+			EventIds: []string{w.Id, ev.Id},
+		}
+	}
+
+	return nil
+}
+
+func newAlertRules(sensor *Sensor) []alertRule {
+	return []alertRule{
+		&webServerShellRule{
+			sensor: sensor,
+		},
+		&sensitiveWriteExecRule{
+			sensor: sensor,
+			writes: make(map[string]*api.Event),
+		},
+	}
+}
+
+// newAlertSource creates a stream of AlertEvents produced by the rules
+// selected by the given filters.
+func newAlertSource(sensor *Sensor, filters []*api.AlertEventFilter) (*stream.Stream, error) {
+	var all bool
+	names := make(map[string]bool)
+	for _, af := range filters {
+		if len(af.Rule) == 0 {
+			all = true
+		}
+		names[af.Rule] = true
+	}
+
+	var rules []alertRule
+	ef := &api.EventFilter{}
+	for _, r := range newAlertRules(sensor) {
+		if !all && !names[r.name()] {
+			continue
+		}
+		rules = append(rules, r)
+
+		rf := r.eventFilter()
+		ef.FileEvents = append(ef.FileEvents, rf.FileEvents...)
+		ef.ProcessEvents = append(ef.ProcessEvents, rf.ProcessEvents...)
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("No alert rules match the alert event filters")
+	}
+
+	pes, err := sensor.createPerfEventStream(&api.Subscription{
+		EventFilter: ef,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if pes == nil {
+		return nil, fmt.Errorf("Couldn't register events for alert rules")
+	}
+
+	alerts := stream.Map(pes, func(e interface{}) interface{} {
+		ev := e.(*api.Event)
+		for _, r := range rules {
+			a := r.inspect(ev)
+			if a == nil {
+				continue
+			}
+
+			glog.V(2).Infof("Alert %s: %s", a.Rule, a.Description)
+
+			alert := sensor.NewEvent()
+			alert.ProcessId = ev.ProcessId
+			alert.ProcessPid = ev.ProcessPid
+			alert.ContainerId = ev.ContainerId
+			alert.ContainerName = ev.ContainerName
+			alert.ImageId = ev.ImageId
+			alert.ImageName = ev.ImageName
+			alert.Kubernetes = ev.Kubernetes
+			alert.ProcessLineage = ev.ProcessLineage
+			alert.Event = &api.Event_Alert{
+				Alert: a,
+			}
+			return alert
+		}
+		return nil
+	})
+
+	return stream.Filter(alerts, filterNils), nil
+}
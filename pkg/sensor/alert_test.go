@@ -0,0 +1,105 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+func TestWebServerShellRule(t *testing.T) {
+	r := &webServerShellRule{}
+
+	ev := &api.Event{
+		Id: "exec",
+		ProcessLineage: []*api.Process{
+			&api.Process{Pid: 200, Command: "nginx"},
+			&api.Process{Pid: 100, Command: "nginx"},
+			&api.Process{Pid: 1, Command: "init"},
+		},
+		Event: &api.Event_Process{
+			Process: &api.ProcessEvent{
+				Type:         api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC,
+				ExecFilename: "/bin/sh",
+			},
+		},
+	}
+	if a := r.inspect(ev); a == nil {
+		t.Error("Expected alert for shell exec'd by nginx")
+	}
+
+	ev.GetProcess().ExecFilename = "/usr/bin/id"
+	if a := r.inspect(ev); a != nil {
+		t.Errorf("Unexpected alert for non-shell exec: %+v", a)
+	}
+}
+
+func TestSensitiveWriteExecRule(t *testing.T) {
+	r := &sensitiveWriteExecRule{
+		writes: make(map[string]*api.Event),
+	}
+
+	open := &api.Event{
+		Id:                  "open",
+		ProcessId:           "p1",
+		SensorMonotimeNanos: 1000,
+		Event: &api.Event_File{
+			File: &api.FileEvent{
+				Type:      api.FileEventType_FILE_EVENT_TYPE_OPEN,
+				Filename:  "/etc/passwd",
+				OpenFlags: 1, // O_WRONLY
+			},
+		},
+	}
+	if a := r.inspect(open); a != nil {
+		t.Errorf("Unexpected alert for open: %+v", a)
+	}
+
+	exec := &api.Event{
+		Id:                  "exec",
+		ProcessId:           "p2",
+		SensorMonotimeNanos: 2000,
+		ProcessLineage: []*api.Process{
+			&api.Process{ProcessId: "p2"},
+			&api.Process{ProcessId: "p1"},
+		},
+		Event: &api.Event_Process{
+			Process: &api.ProcessEvent{
+				Type:         api.ProcessEventType_PROCESS_EVENT_TYPE_EXEC,
+				ExecFilename: "/bin/su",
+			},
+		},
+	}
+	a := r.inspect(exec)
+	if a == nil {
+		t.Fatal("Expected alert for exec by child of writing process")
+	}
+	if len(a.EventIds) != 2 || a.EventIds[0] != "open" || a.EventIds[1] != "exec" {
+		t.Errorf("Unexpected event ids %v", a.EventIds)
+	}
+
+	// The pending write is consumed by the first alert
+	if a = r.inspect(exec); a != nil {
+		t.Errorf("Unexpected second alert: %+v", a)
+	}
+
+	// Writes older than the correlation window are forgotten
+	r.inspect(open)
+	exec.SensorMonotimeNanos = open.SensorMonotimeNanos + alertCorrelationWindow + 1
+	if a = r.inspect(exec); a != nil {
+		t.Errorf("Unexpected alert outside correlation window: %+v", a)
+	}
+}
@@ -15,6 +15,8 @@
 package sensor
 
 import (
+	"time"
+
 	api "github.com/capsule8/capsule8/api/v0"
 
 	"github.com/capsule8/capsule8/pkg/stream"
@@ -55,6 +57,63 @@ func (c *chargen) emitNextEvent(e interface{}) {
 	}
 }
 
+// chargenMinInterval is the shortest interval at which rate-limited chargen
+// events are generated. Higher rates are reached by generating several
+// events per interval.
+const chargenMinInterval = time.Millisecond
+
+// chargenCharacter returns the character at the given index of the
+// generated character stream, which cycles through the printable ASCII
+// characters like stream.Chargen.
+func chargenCharacter(index uint64) byte {
+	return byte(' ' + index%('~'-' '+1))
+}
+
+// emitPacedEvents generates events directly rather than from a
+// stream.Chargen so that they can be generated at a specified rate.
+func (c *chargen) emitPacedEvents(n uint64) {
+	for ; n > 0; n-- {
+		for i := range c.payload {
+			c.payload[i] = chargenCharacter(c.index)
+			c.index++
+		}
+		c.data <- c.newChargenEvent(c.index, string(c.payload))
+	}
+}
+
+func (c *chargen) pacedLoop() {
+	interval := time.Second / time.Duration(c.filter.Rate)
+	if interval < chargenMinInterval {
+		interval = chargenMinInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// Generate as many events as are due at each tick so that the
+	// requested rate is kept even when it doesn't divide evenly into
+	// ticks.
+	start := time.Now()
+	var emitted uint64
+
+	for {
+		select {
+		case _, ok := <-c.ctrl:
+			if !ok {
+				close(c.data)
+				return
+			}
+
+		case now := <-ticker.C:
+			due := uint64(now.Sub(start).Seconds() * float64(c.filter.Rate))
+			if due > emitted {
+				c.emitPacedEvents(due - emitted)
+				emitted = due
+			}
+		}
+	}
+}
+
 func newChargenSource(sensor *Sensor, filter *api.ChargenEventFilter) (*stream.Stream, error) {
 	// Each call to New creates a new session with the Sensor. It is the
 	// Sensor's responsibility to handle all of its sessions in the most
@@ -68,12 +127,21 @@ func newChargenSource(sensor *Sensor, filter *api.ChargenEventFilter) (*stream.S
 		data:    make(chan interface{}),
 		sensor:  sensor,
 		filter:  filter,
-		chargen: stream.Chargen(),
 		index:   0,
 		length:  filter.Length,
 		payload: make([]byte, filter.Length),
 	}
 
+	if filter.Rate > 0 {
+		go c.pacedLoop()
+
+		return &stream.Stream{
+			Ctrl: c.ctrl,
+			Data: c.data,
+		}, nil
+	}
+
+	c.chargen = stream.Chargen()
 	go func() {
 		for {
 			select {
@@ -0,0 +1,52 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+func TestChargenRate(t *testing.T) {
+	s, err := newChargenSource(&Sensor{}, &api.ChargenEventFilter{
+		Length: 4,
+		Rate:   1000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	start := time.Now()
+	for i := 1; i <= 100; i++ {
+		e := (<-s.Data).(*api.Event)
+		cg := e.GetChargen()
+		if cg.Index != uint64(4*i) {
+			t.Fatalf("Expected index %d, got %d", 4*i, cg.Index)
+		}
+		if len(cg.Characters) != 4 ||
+			cg.Characters[0] != chargenCharacter(uint64(4*(i-1))) {
+			t.Fatalf("Unexpected characters %q at index %d",
+				cg.Characters, cg.Index)
+		}
+	}
+
+	// 100 events at 1000 per second should take about 100ms
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Expected events to be paced, got 100 in %s", elapsed)
+	}
+}
@@ -183,6 +183,7 @@ func (cer *containerEventRepeater) translateContainerEvents(e interface{}) inter
 		ev.Event = &api.Event_Container{
 			Container: ece,
 		}
+		cer.sensor.redactor.redact(ev)
 
 		return ev
 	}
@@ -404,6 +405,11 @@ func (c *containerFilter) FilterFunc(i interface{}) bool {
 	}
 
 	switch e.Event.(type) {
+	case *api.Event_LostEvents:
+		// Lost events are reported for the whole sensor, not for
+		// any particular container.
+		return true
+
 	case *api.Event_Container:
 		cev := e.GetContainer()
 
@@ -28,13 +28,29 @@ import (
 const (
 	fsDoSysOpenKprobeAddress   = "do_sys_open"
 	fsDoSysOpenKprobeFetchargs = "filename=+0(%si):string flags=%dx:s32 mode=%cx:s32"
+
+	fsSysUnlinkKprobeAddress     = "sys_unlink"
+	fsSysUnlinkKprobeFetchargs   = "filename=+0(%di):string"
+	fsSysUnlinkatKprobeAddress   = "sys_unlinkat"
+	fsSysUnlinkatKprobeFetchargs = "filename=+0(%si):string"
+
+	fsSysRenameKprobeAddress     = "sys_rename"
+	fsSysRenameKprobeFetchargs   = "filename=+0(%di):string newname=+0(%si):string"
+	fsSysRenameatKprobeAddress   = "sys_renameat"
+	fsSysRenameat2KprobeAddress  = "sys_renameat2"
+	fsSysRenameatKprobeFetchargs = "filename=+0(%si):string newname=+0(%cx):string"
+
+	fsSysChmodKprobeAddress      = "sys_chmod"
+	fsSysChmodKprobeFetchargs    = "filename=+0(%di):string mode=%si:u16"
+	fsSysFchmodatKprobeAddress   = "sys_fchmodat"
+	fsSysFchmodatKprobeFetchargs = "filename=+0(%si):string mode=%dx:u16"
 )
 
-type fileOpenFilter struct {
+type fileFilter struct {
 	sensor *Sensor
 }
 
-func (f *fileOpenFilter) decodeDoSysOpen(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+func (f *fileFilter) decodeDoSysOpen(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
 	ev := f.sensor.NewEventFromSample(sample, data)
 	ev.Event = &api.Event_File{
 		File: &api.FileEvent{
@@ -48,7 +64,57 @@ func (f *fileOpenFilter) decodeDoSysOpen(sample *perf.SampleRecord, data perf.Tr
 	return ev, nil
 }
 
+func (f *fileFilter) decodeUnlink(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_File{
+		File: &api.FileEvent{
+			Type:     api.FileEventType_FILE_EVENT_TYPE_UNLINK,
+			Filename: data["filename"].(string),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *fileFilter) decodeRename(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_File{
+		File: &api.FileEvent{
+			Type:              api.FileEventType_FILE_EVENT_TYPE_RENAME,
+			Filename:          data["filename"].(string),
+			RenameNewFilename: data["newname"].(string),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *fileFilter) decodeChmod(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_File{
+		File: &api.FileEvent{
+			Type:      api.FileEventType_FILE_EVENT_TYPE_CHMOD,
+			Filename:  data["filename"].(string),
+			ChmodMode: int32(data["mode"].(uint16)),
+		},
+	}
+
+	return ev, nil
+}
+
 func rewriteFileEventFilter(fef *api.FileEventFilter) {
+	if len(fef.PathPatterns) > 0 {
+		var patterns *api.Expression
+		for _, p := range fef.PathPatterns {
+			patterns = expression.LogicalOr(patterns, expression.Like(
+				expression.Identifier("filename"),
+				expression.Value(p)))
+		}
+		fef.FilterExpression = expression.LogicalAnd(
+			fef.FilterExpression, patterns)
+		fef.PathPatterns = nil
+	}
+
 	if fef.Filename != nil {
 		newExpr := expression.Equal(
 			expression.Identifier("filename"),
@@ -78,62 +144,61 @@ func rewriteFileEventFilter(fef *api.FileEventFilter) {
 	if fef.CreateModeMask != nil {
 		newExpr := expression.BitwiseAnd(
 			expression.Identifier("mode"),
-			expression.Value(fef.OpenFlagsMask.Value))
+			expression.Value(fef.CreateModeMask.Value))
 		fef.FilterExpression = expression.LogicalAnd(
 			newExpr, fef.FilterExpression)
 		fef.CreateModeMask = nil
 	}
 }
 
-func registerFileEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.FileEventFilter) {
-	var filterString string
-
-	wildcard := false
-	filters := make(map[string]bool, len(events))
-	for _, fef := range events {
-		if fef.Type != api.FileEventType_FILE_EVENT_TYPE_OPEN {
-			continue
-		}
-
-		// Translate deprecated fields into an expression
-		rewriteFileEventFilter(fef)
+// fileFilterSet collects the kernel filters requested for a single file
+// event type across all of a subscription's file event filters.
+type fileFilterSet struct {
+	wildcard bool
+	filters  map[string]bool
+}
 
-		if fef.FilterExpression == nil {
-			wildcard = true
-		} else {
-			expr, err := expression.NewExpression(fef.FilterExpression)
-			if err != nil {
-				glog.V(1).Infof("Invalid file event filter: %s", err)
-				continue
-			}
-			err = expr.ValidateKernelFilter()
-			if err != nil {
-				glog.V(1).Infof("Invalid file event filter as kernel filter: %s", err)
-				continue
-			}
-			s := expr.KernelFilterString()
-			filters[s] = true
-		}
+func (ffs *fileFilterSet) add(fef *api.FileEventFilter) {
+	if fef.FilterExpression == nil {
+		ffs.wildcard = true
+		return
 	}
 
-	if !wildcard {
-		if len(filters) == 0 {
-			return
-		}
-
-		parts := make([]string, 0, len(filters))
-		for k := range filters {
-			parts = append(parts, fmt.Sprintf("(%s)", k))
-		}
-		filterString = strings.Join(parts, " || ")
-	} else if len(events) == 0 {
+	expr, err := expression.NewExpression(fef.FilterExpression)
+	if err != nil {
+		glog.V(1).Infof("Invalid file event filter: %s", err)
+		return
+	}
+	err = expr.ValidateKernelFilter()
+	if err != nil {
+		glog.V(1).Infof("Invalid file event filter as kernel filter: %s", err)
 		return
 	}
+	if ffs.filters == nil {
+		ffs.filters = make(map[string]bool)
+	}
+	ffs.filters[expr.KernelFilterString()] = true
+}
 
-	f := fileOpenFilter{
-		sensor: sensor,
+func (ffs *fileFilterSet) filterString() (string, bool) {
+	if ffs == nil {
+		return "", false
+	}
+	if ffs.wildcard {
+		return "", true
 	}
+	if len(ffs.filters) == 0 {
+		return "", false
+	}
+
+	parts := make([]string, 0, len(ffs.filters))
+	for k := range ffs.filters {
+		parts = append(parts, fmt.Sprintf("(%s)", k))
+	}
+	return strings.Join(parts, " || "), true
+}
 
+func registerFileOpenEvents(sensor *Sensor, eventMap subscriptionMap, f *fileFilter, filterString string) {
 	eventID, err := sensor.monitor.RegisterTracepoint("fs/do_sys_open", f.decodeDoSysOpen,
 		perf.WithFilter(filterString))
 	if err != nil {
@@ -153,3 +218,69 @@ func registerFileEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.
 
 	eventMap[eventID] = &subscription{}
 }
+
+func registerFileKprobe(sensor *Sensor, eventMap subscriptionMap, address string, fetchargs string, fn perf.TraceEventDecoderFn, filterString string) {
+	eventID, err := sensor.monitor.RegisterKprobe(address, false, fetchargs, fn,
+		perf.WithFilter(filterString))
+	if err != nil {
+		glog.V(1).Infof("Couldn't register file kprobe %s: %v", address, err)
+		return
+	}
+
+	eventMap[eventID] = &subscription{}
+}
+
+func registerFileEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.FileEventFilter) {
+	filterSets := make(map[api.FileEventType]*fileFilterSet)
+	for _, fef := range events {
+		switch fef.Type {
+		case api.FileEventType_FILE_EVENT_TYPE_OPEN,
+			api.FileEventType_FILE_EVENT_TYPE_UNLINK,
+			api.FileEventType_FILE_EVENT_TYPE_RENAME,
+			api.FileEventType_FILE_EVENT_TYPE_CHMOD:
+		default:
+			continue
+		}
+
+		// Translate deprecated fields into an expression
+		rewriteFileEventFilter(fef)
+
+		ffs, ok := filterSets[fef.Type]
+		if !ok {
+			ffs = &fileFilterSet{}
+			filterSets[fef.Type] = ffs
+		}
+		ffs.add(fef)
+	}
+
+	f := fileFilter{
+		sensor: sensor,
+	}
+
+	if s, ok := filterSets[api.FileEventType_FILE_EVENT_TYPE_OPEN].filterString(); ok {
+		registerFileOpenEvents(sensor, eventMap, &f, s)
+	}
+
+	if s, ok := filterSets[api.FileEventType_FILE_EVENT_TYPE_UNLINK].filterString(); ok {
+		registerFileKprobe(sensor, eventMap, fsSysUnlinkKprobeAddress,
+			fsSysUnlinkKprobeFetchargs, f.decodeUnlink, s)
+		registerFileKprobe(sensor, eventMap, fsSysUnlinkatKprobeAddress,
+			fsSysUnlinkatKprobeFetchargs, f.decodeUnlink, s)
+	}
+
+	if s, ok := filterSets[api.FileEventType_FILE_EVENT_TYPE_RENAME].filterString(); ok {
+		registerFileKprobe(sensor, eventMap, fsSysRenameKprobeAddress,
+			fsSysRenameKprobeFetchargs, f.decodeRename, s)
+		registerFileKprobe(sensor, eventMap, fsSysRenameatKprobeAddress,
+			fsSysRenameatKprobeFetchargs, f.decodeRename, s)
+		registerFileKprobe(sensor, eventMap, fsSysRenameat2KprobeAddress,
+			fsSysRenameatKprobeFetchargs, f.decodeRename, s)
+	}
+
+	if s, ok := filterSets[api.FileEventType_FILE_EVENT_TYPE_CHMOD].filterString(); ok {
+		registerFileKprobe(sensor, eventMap, fsSysChmodKprobeAddress,
+			fsSysChmodKprobeFetchargs, f.decodeChmod, s)
+		registerFileKprobe(sensor, eventMap, fsSysFchmodatKprobeAddress,
+			fsSysFchmodatKprobeFetchargs, f.decodeChmod, s)
+	}
+}
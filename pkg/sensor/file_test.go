@@ -0,0 +1,152 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+func TestRewriteFileEventFilter(t *testing.T) {
+	fef := &api.FileEventFilter{
+		Type:         api.FileEventType_FILE_EVENT_TYPE_OPEN,
+		PathPatterns: []string{"/etc/*", "/usr/bin/*"},
+		FilterExpression: expression.BitwiseAnd(
+			expression.Identifier("flags"),
+			expression.Value(int32(3))),
+	}
+	rewriteFileEventFilter(fef)
+	if len(fef.PathPatterns) != 0 {
+		t.Error("Expected path patterns to be rewritten")
+	}
+
+	ffs := fileFilterSet{}
+	ffs.add(fef)
+	s, ok := ffs.filterString()
+	want := `(flags & 3 && (filename ~ "/etc/*" || filename ~ "/usr/bin/*"))`
+	if !ok || s != want {
+		t.Errorf("Expected %s, got %q", want, s)
+	}
+
+	// The user's expression must keep its own grouping
+	fef = &api.FileEventFilter{
+		Type:         api.FileEventType_FILE_EVENT_TYPE_OPEN,
+		PathPatterns: []string{"/etc/*"},
+		FilterExpression: expression.LogicalOr(
+			expression.Equal(
+				expression.Identifier("flags"),
+				expression.Value(int32(1))),
+			expression.Equal(
+				expression.Identifier("flags"),
+				expression.Value(int32(2)))),
+	}
+	rewriteFileEventFilter(fef)
+	ffs = fileFilterSet{}
+	ffs.add(fef)
+	s, ok = ffs.filterString()
+	want = `((flags == 1 || flags == 2) && filename ~ "/etc/*")`
+	if !ok || s != want {
+		t.Errorf("Expected %s, got %q", want, s)
+	}
+
+	fef = &api.FileEventFilter{
+		Type:     api.FileEventType_FILE_EVENT_TYPE_UNLINK,
+		Filename: &wrappers.StringValue{Value: "/etc/shadow"},
+	}
+	rewriteFileEventFilter(fef)
+	if fef.Filename != nil {
+		t.Error("Expected deprecated filename to be rewritten")
+	}
+	ffs = fileFilterSet{}
+	ffs.add(fef)
+	if s, ok = ffs.filterString(); !ok || s != `(filename == "/etc/shadow")` {
+		t.Errorf("Unexpected filter string %q", s)
+	}
+}
+
+func TestFileFilterString(t *testing.T) {
+	var none *fileFilterSet
+	if _, ok := none.filterString(); ok {
+		t.Error("Expected no filter for unrequested event type")
+	}
+
+	ffs := fileFilterSet{}
+	ffs.add(&api.FileEventFilter{
+		Type: api.FileEventType_FILE_EVENT_TYPE_CHMOD,
+		// Not a valid kernel filter
+		FilterExpression: expression.IsNull(expression.Identifier("filename")),
+	})
+	if _, ok := ffs.filterString(); ok {
+		t.Error("Expected no filter when all filters are invalid")
+	}
+
+	ffs.add(&api.FileEventFilter{
+		Type: api.FileEventType_FILE_EVENT_TYPE_CHMOD,
+		FilterExpression: expression.Like(
+			expression.Identifier("filename"),
+			expression.Value("/etc/*")),
+	})
+	if s, ok := ffs.filterString(); !ok || s != `(filename ~ "/etc/*")` {
+		t.Errorf("Unexpected filter string %q", s)
+	}
+
+	ffs.add(&api.FileEventFilter{Type: api.FileEventType_FILE_EVENT_TYPE_CHMOD})
+	if s, ok := ffs.filterString(); !ok || s != "" {
+		t.Errorf("Expected wildcard filter, got %q", s)
+	}
+}
+
+func TestFileDecoders(t *testing.T) {
+	s := &Sensor{}
+	s.processCache.cache = newMapTaskCache()
+	f := fileFilter{sensor: s}
+	sample := &perf.SampleRecord{}
+
+	e, _ := f.decodeUnlink(sample, perf.TraceEventSampleData{
+		"common_pid": int32(1),
+		"filename":   "/tmp/x",
+	})
+	fe := e.(*api.Event).GetFile()
+	if fe.Type != api.FileEventType_FILE_EVENT_TYPE_UNLINK || fe.Filename != "/tmp/x" {
+		t.Errorf("Unexpected unlink event %+v", fe)
+	}
+
+	e, _ = f.decodeRename(sample, perf.TraceEventSampleData{
+		"common_pid": int32(1),
+		"filename":   "/tmp/x",
+		"newname":    "/tmp/y",
+	})
+	fe = e.(*api.Event).GetFile()
+	if fe.Type != api.FileEventType_FILE_EVENT_TYPE_RENAME ||
+		fe.Filename != "/tmp/x" || fe.RenameNewFilename != "/tmp/y" {
+		t.Errorf("Unexpected rename event %+v", fe)
+	}
+
+	e, _ = f.decodeChmod(sample, perf.TraceEventSampleData{
+		"common_pid": int32(1),
+		"filename":   "/tmp/x",
+		"mode":       uint16(0755),
+	})
+	fe = e.(*api.Event).GetFile()
+	if fe.Type != api.FileEventType_FILE_EVENT_TYPE_CHMOD ||
+		fe.Filename != "/tmp/x" || fe.ChmodMode != 0755 {
+		t.Errorf("Unexpected chmod event %+v", fe)
+	}
+}
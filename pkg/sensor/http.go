@@ -0,0 +1,377 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/cloudevents"
+	"github.com/capsule8/capsule8/pkg/config"
+	"github.com/capsule8/capsule8/pkg/stream"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/jsonpb"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/websocket"
+)
+
+// HTTPTelemetryService is a service that can be used with the ServiceManager
+// to process telemetry subscription requests over HTTP and stream the
+// resulting telemetry events as newline-delimited JSON.
+//
+// A subscription is made by POSTing a JSON-encoded GetEventsRequest to
+// /v0/events. Each line of the response body is a JSON-encoded
+// GetEventsResponse. The first and last responses carry the status of the
+// subscription. The subscription lasts until the client disconnects.
+//
+// POSTing the same request to /v0/cloudevents streams each event as a
+// structured-mode CloudEvent on its own line instead.
+//
+// GETting /v0/schema returns a JSON-encoded GetSchemaResponse describing the
+// schema of the events.
+//
+// Subscriptions may also be made over a WebSocket at /v0/events/ws. The
+// first message sent by the client is a JSON-encoded GetEventsRequest, and
+// each message sent by the sensor is a JSON-encoded GetEventsResponse. If the
+// client doesn't keep up, events are dropped and the number of dropped
+// events is reported in the next response. WebSocket connections from web
+// pages are refused unless their Origin is listed in
+// config.Sensor.HTTPAllowedOrigins.
+type HTTPTelemetryService struct {
+	server *http.Server
+	sensor *Sensor
+
+	address string
+}
+
+// NewHTTPTelemetryService creates a new HTTPTelemetryService instance that
+// can be used with a ServiceManager instance.
+func NewHTTPTelemetryService(sensor *Sensor, address string) *HTTPTelemetryService {
+	return &HTTPTelemetryService{
+		address: address,
+		sensor:  sensor,
+	}
+}
+
+// Name returns the human-readable name of the HTTPTelemetryService.
+func (hs *HTTPTelemetryService) Name() string {
+	return "HTTP Telemetry Server"
+}
+
+// Serve is the main entrypoint for the HTTPTelemetryService. It is normally
+// called by the ServiceManager. It will service requests indefinitely from
+// the calling Goroutine.
+func (hs *HTTPTelemetryService) Serve() error {
+	glog.V(1).Info("Serving HTTP API on ", hs.address)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v0/events", hs.handleEvents)
+	mux.HandleFunc("/v0/cloudevents", hs.handleCloudEvents)
+	mux.HandleFunc("/v0/schema", hs.handleSchema)
+	mux.Handle("/v0/events/ws", websocket.Server{
+		Handler:   hs.handleEventsWebSocket,
+		Handshake: checkWebSocketOrigin,
+	})
+
+	hs.server = &http.Server{
+		Addr:    hs.address,
+		Handler: mux,
+	}
+
+	err := hs.server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		glog.Errorf("HTTP API error: %s", err)
+		return err
+	}
+
+	return nil
+}
+
+// Stop will stop a running HTTPTelemetryService. Subscriptions are ended
+// and their subscribers are given until the configured shutdown timeout to
+// receive their remaining events before their connections are closed.
+func (hs *HTTPTelemetryService) Stop() {
+	hs.sensor.StopSubscriptions()
+
+	ctx, cancel := context.WithTimeout(context.Background(),
+		config.Sensor.ShutdownTimeout)
+	defer cancel()
+
+	if err := hs.server.Shutdown(ctx); err != nil {
+		glog.Warningf("Timed out waiting for HTTP subscribers, closing connections: %s", err)
+		hs.server.Close()
+	}
+}
+
+// subscribe creates a subscription from the JSON-encoded GetEventsRequest
+// POSTed in r. If the subscription can't be created, an error response is
+// written to w and a nil *api.Subscription is returned. The subscription is
+// closed when the client disconnects.
+func (hs *HTTPTelemetryService) subscribe(w http.ResponseWriter, r *http.Request) (*api.Subscription, *stream.Stream, *subscriptionStatus) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return nil, nil, nil
+	}
+
+	req := &api.GetEventsRequest{}
+	err := jsonpb.Unmarshal(r.Body, req)
+	if err != nil {
+		http.Error(w, "Invalid request: "+err.Error(), http.StatusBadRequest)
+		return nil, nil, nil
+	}
+
+	sub := req.Subscription
+	glog.V(1).Infof("HTTP GetEvents(%+v)", sub)
+
+	eventStream, subStatus, err := hs.sensor.newSubscription(sub)
+	if _, ok := err.(*InvalidSubscriptionError); ok {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, nil, nil
+	} else if err != nil {
+		glog.Errorf("Failed to get events for subscription %+v: %s",
+			sub, err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return nil, nil, nil
+	}
+
+	go func() {
+		<-r.Context().Done()
+		glog.V(1).Infof("HTTP client disconnected, closing stream")
+		eventStream.Close()
+	}()
+
+	return sub, eventStream, subStatus
+}
+
+// writeLine writes b to w followed by a newline and flushes it to the client
+func writeLine(w http.ResponseWriter, b []byte) error {
+	if _, err := w.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+func (hs *HTTPTelemetryService) handleEvents(w http.ResponseWriter, r *http.Request) {
+	sub, eventStream, subStatus := hs.subscribe(w, r)
+	if sub == nil {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+
+	marshaler := &jsonpb.Marshaler{}
+	send := func(resp *api.GetEventsResponse) error {
+		var buf bytes.Buffer
+		if err := marshaler.Marshal(&buf, resp); err != nil {
+			return err
+		}
+		return writeLine(w, buf.Bytes())
+	}
+
+	if send(&api.GetEventsResponse{Status: subStatus.snapshot()}) != nil {
+		return
+	}
+
+	var mod api.BatchModifier
+	if sub.Modifier != nil && sub.Modifier.Batch != nil {
+		mod = *sub.Modifier.Batch
+	}
+	if sendBatches(eventStream.Data, send, mod) == nil {
+		sendFinalStatus(send, subStatus)
+	}
+}
+
+func (hs *HTTPTelemetryService) handleSchema(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	resp, err := api.NewGetSchemaResponse()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	marshaler := &jsonpb.Marshaler{}
+	marshaler.Marshal(w, resp)
+}
+
+// cloudEventsMapping returns the configured mapping of telemetry events to
+// CloudEvents
+func cloudEventsMapping() cloudevents.Mapping {
+	m := cloudevents.DefaultMapping
+	if len(config.Sensor.CloudEventsSource) > 0 {
+		m.Source = config.Sensor.CloudEventsSource
+	}
+	if len(config.Sensor.CloudEventsType) > 0 {
+		m.Type = config.Sensor.CloudEventsType
+	}
+	if len(config.Sensor.CloudEventsSubject) > 0 {
+		m.Subject = config.Sensor.CloudEventsSubject
+	}
+	return m
+}
+
+func (hs *HTTPTelemetryService) handleCloudEvents(w http.ResponseWriter, r *http.Request) {
+	sub, eventStream, _ := hs.subscribe(w, r)
+	if sub == nil {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+
+	mapping := cloudEventsMapping()
+	for e := range eventStream.Data {
+		ce, err := cloudevents.New(e.(*api.Event), mapping, time.Now())
+		if err != nil {
+			glog.Warningf("Couldn't create CloudEvent: %s", err)
+			continue
+		}
+
+		b, err := ce.MarshalStructured()
+		if err != nil {
+			glog.Warningf("Couldn't encode CloudEvent: %s", err)
+			continue
+		}
+
+		if writeLine(w, b) != nil {
+			return
+		}
+	}
+}
+
+// checkWebSocketOrigin refuses WebSocket connections whose Origin isn't
+// listed in config.Sensor.HTTPAllowedOrigins, so that a web page open in a
+// browser on the host can't read telemetry. Clients other than browsers
+// may omit the Origin.
+func checkWebSocketOrigin(_ *websocket.Config, r *http.Request) error {
+	origin := r.Header.Get("Origin")
+	if len(origin) == 0 {
+		return nil
+	}
+
+	for _, allowed := range config.Sensor.HTTPAllowedOrigins {
+		if strings.EqualFold(origin, allowed) {
+			return nil
+		}
+	}
+
+	glog.Warningf("Refused WebSocket connection from origin %s", origin)
+	return fmt.Errorf("Origin %s is not allowed", origin)
+}
+
+func (hs *HTTPTelemetryService) handleEventsWebSocket(ws *websocket.Conn) {
+	defer ws.Close()
+
+	sendError := func(err error) {
+		b, _ := json.Marshal(map[string]string{
+			"error": err.Error(),
+		})
+		websocket.Message.Send(ws, string(b))
+	}
+
+	var msg string
+	err := websocket.Message.Receive(ws, &msg)
+	if err != nil {
+		return
+	}
+
+	req := &api.GetEventsRequest{}
+	err = jsonpb.UnmarshalString(msg, req)
+	if err != nil {
+		sendError(err)
+		return
+	}
+
+	sub := req.Subscription
+	glog.V(1).Infof("WebSocket GetEvents(%+v)", sub)
+
+	eventStream, subStatus, err := hs.sensor.newSubscription(sub)
+	if _, ok := err.(*InvalidSubscriptionError); ok {
+		sendError(err)
+		return
+	} else if err != nil {
+		glog.Errorf("Failed to get events for subscription %+v: %s",
+			sub, err.Error())
+		sendError(err)
+		return
+	}
+	defer eventStream.Close()
+
+	// Nothing more is expected from the client, so a failed read means
+	// that it has gone away.
+	go func() {
+		var discard string
+		for websocket.Message.Receive(ws, &discard) == nil {
+		}
+		glog.V(1).Infof("WebSocket client disconnected, closing stream")
+		eventStream.Close()
+		ws.Close()
+	}()
+
+	// Decouple the subscription from the socket so that a slow client
+	// causes events to be dropped rather than blocking the sensor.
+	var dropped uint64
+	pending := make(chan interface{}, config.Sensor.ChannelBufferLength)
+	go func() {
+		defer close(pending)
+		for e := range eventStream.Data {
+			select {
+			case pending <- e:
+			default:
+				atomic.AddUint64(&dropped, 1)
+			}
+		}
+	}()
+
+	marshaler := &jsonpb.Marshaler{}
+	send := func(resp *api.GetEventsResponse) error {
+		resp.DroppedEvents = atomic.SwapUint64(&dropped, 0)
+		s, err := marshaler.MarshalToString(resp)
+		if err != nil {
+			return err
+		}
+		return websocket.Message.Send(ws, s)
+	}
+
+	if send(&api.GetEventsResponse{Status: subStatus.snapshot()}) != nil {
+		return
+	}
+
+	var mod api.BatchModifier
+	if sub.Modifier != nil && sub.Modifier.Batch != nil {
+		mod = *sub.Modifier.Batch
+	}
+	if sendBatches(pending, send, mod) == nil {
+		sendFinalStatus(send, subStatus)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/capsule8/capsule8/pkg/config"
+	"github.com/golang/protobuf/jsonpb"
+
+	"golang.org/x/net/websocket"
+)
+
+func TestHTTPEventsRejectsBadRequests(t *testing.T) {
+	hs := NewHTTPTelemetryService(nil, "")
+
+	tests := []struct {
+		method string
+		body   string
+		status int
+	}{
+		{"GET", "", http.StatusMethodNotAllowed},
+		{"POST", "not json", http.StatusBadRequest},
+		{"POST", "{}", http.StatusBadRequest},
+		{"POST", `{"subscription": {"eventFilter": {"fileEvents": [{"type": "FILE_EVENT_TYPE_OPEN", "filterExpression": {"type": "IS_NULL", "unaryOp": {"type": "IDENTIFIER", "identifier": "filename"}}}]}}}`, http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		r := httptest.NewRequest(tc.method, "/v0/events",
+			strings.NewReader(tc.body))
+		w := httptest.NewRecorder()
+		hs.handleEvents(w, r)
+		if w.Code != tc.status {
+			t.Errorf("%s %q: expected status %d, got %d (%s)",
+				tc.method, tc.body, tc.status, w.Code, w.Body.String())
+		}
+
+		r = httptest.NewRequest(tc.method, "/v0/cloudevents",
+			strings.NewReader(tc.body))
+		w = httptest.NewRecorder()
+		hs.handleCloudEvents(w, r)
+		if w.Code != tc.status {
+			t.Errorf("CloudEvents %s %q: expected status %d, got %d (%s)",
+				tc.method, tc.body, tc.status, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestWebSocketEventsRejectsBadRequests(t *testing.T) {
+	hs := NewHTTPTelemetryService(nil, "")
+	server := httptest.NewServer(websocket.Server{
+		Handler:   hs.handleEventsWebSocket,
+		Handshake: checkWebSocketOrigin,
+	})
+	defer server.Close()
+
+	defer func(origins []string) {
+		config.Sensor.HTTPAllowedOrigins = origins
+	}(config.Sensor.HTTPAllowedOrigins)
+	config.Sensor.HTTPAllowedOrigins = nil
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	if ws, err := websocket.Dial(url, "", server.URL); err == nil {
+		ws.Close()
+		t.Fatal("Expected connection from unlisted origin to be refused")
+	}
+
+	config.Sensor.HTTPAllowedOrigins = []string{server.URL}
+	ws, err := websocket.Dial(url, "", server.URL)
+	if err != nil {
+		t.Fatalf("Couldn't connect: %s", err)
+	}
+	defer ws.Close()
+
+	if err = websocket.Message.Send(ws, "{}"); err != nil {
+		t.Fatalf("Couldn't send request: %s", err)
+	}
+
+	var reply string
+	if err = websocket.Message.Receive(ws, &reply); err != nil {
+		t.Fatalf("Couldn't receive reply: %s", err)
+	}
+	if !strings.Contains(reply, "error") {
+		t.Errorf("Expected error reply, got %s", reply)
+	}
+}
+
+func TestCheckWebSocketOrigin(t *testing.T) {
+	defer func(origins []string) {
+		config.Sensor.HTTPAllowedOrigins = origins
+	}(config.Sensor.HTTPAllowedOrigins)
+	config.Sensor.HTTPAllowedOrigins = []string{"https://console.example.com"}
+
+	tests := []struct {
+		origin  string
+		allowed bool
+	}{
+		{"", true},
+		{"https://console.example.com", true},
+		{"HTTPS://CONSOLE.EXAMPLE.COM", true},
+		{"https://evil.example.com", false},
+		{"null", false},
+	}
+
+	for _, tc := range tests {
+		r := httptest.NewRequest("GET", "/v0/events/ws", nil)
+		if len(tc.origin) > 0 {
+			r.Header.Set("Origin", tc.origin)
+		}
+		err := checkWebSocketOrigin(nil, r)
+		if tc.allowed && err != nil {
+			t.Errorf("Expected origin %q to be allowed: %s", tc.origin, err)
+		} else if !tc.allowed && err == nil {
+			t.Errorf("Expected origin %q to be refused", tc.origin)
+		}
+	}
+}
+
+func TestHTTPSchema(t *testing.T) {
+	hs := NewHTTPTelemetryService(nil, "")
+
+	r := httptest.NewRequest("POST", "/v0/schema", nil)
+	w := httptest.NewRecorder()
+	hs.handleSchema(w, r)
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status %d, got %d", http.StatusMethodNotAllowed,
+			w.Code)
+	}
+
+	r = httptest.NewRequest("GET", "/v0/schema", nil)
+	w = httptest.NewRecorder()
+	hs.handleSchema(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d (%s)", http.StatusOK,
+			w.Code, w.Body.String())
+	}
+
+	resp := &api.GetSchemaResponse{}
+	if err := jsonpb.Unmarshal(w.Body, resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.SchemaVersion != api.SchemaVersion {
+		t.Errorf("Expected schema version %d, got %d",
+			api.SchemaVersion, resp.SchemaVersion)
+	}
+	if len(resp.FileDescriptorSet) == 0 {
+		t.Error("Expected a FileDescriptorSet")
+	}
+}
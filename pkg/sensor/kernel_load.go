@@ -0,0 +1,130 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+
+	"github.com/golang/glog"
+)
+
+const (
+	kernelLoadModuleTracepoint = "module/module_load"
+
+	kernelLoadBPFKprobeSymbol = "sys_bpf"
+
+	// These offsets index into the BPF_PROG_LOAD variant of union
+	// bpf_attr, which is part of the kernel's user ABI.
+	kernelLoadBPFKprobeFetchargs = "cmd=%di:s32 " +
+		"prog_type=+0(%si):u32 " +
+		"insn_cnt=+4(%si):u32 " +
+		"prog_name=+48(%si):string"
+
+	// BPF_PROG_LOAD from include/uapi/linux/bpf.h
+	bpfProgLoad = 5
+)
+
+type kernelLoadFilter struct {
+	sensor *Sensor
+}
+
+func (f *kernelLoadFilter) decodeModuleLoad(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_KernelLoad{
+		KernelLoad: &api.KernelLoadEvent{
+			Type:         api.KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_MODULE,
+			ModuleName:   data["name"].(string),
+			ModuleTaints: data["taints"].(uint32),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *kernelLoadFilter) decodeSysBPF(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	kev := &api.KernelLoadEvent{
+		Type:   api.KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_BPF,
+		BpfCmd: data["cmd"].(int32),
+	}
+	if kev.BpfCmd == bpfProgLoad {
+		kev.BpfProgType = data["prog_type"].(uint32)
+		kev.BpfInsnCnt = data["insn_cnt"].(uint32)
+		kev.BpfProgName = data["prog_name"].(string)
+	}
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_KernelLoad{
+		KernelLoad: kev,
+	}
+
+	return ev, nil
+}
+
+type kernelLoadFilterSet struct {
+	moduleFilters map[string]int
+	bpfFilters    map[string]int
+}
+
+func (kfs *kernelLoadFilterSet) add(kef *api.KernelLoadEventFilter) {
+	var filterString string
+
+	if kef.FilterExpression != nil {
+		expr, err := expression.NewExpression(kef.FilterExpression)
+		if err != nil {
+			glog.V(1).Infof("Bad kernel load filter expression: %s", err)
+			return
+		}
+
+		err = expr.ValidateKernelFilter()
+		if err != nil {
+			glog.V(1).Infof("Bad kernel load filter expression: %s", err)
+			return
+		}
+
+		filterString = expr.KernelFilterString()
+	}
+
+	switch kef.Type {
+	case api.KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_MODULE:
+		if kfs.moduleFilters == nil {
+			kfs.moduleFilters = make(map[string]int)
+		}
+		kfs.moduleFilters[filterString]++
+	case api.KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_BPF:
+		if kfs.bpfFilters == nil {
+			kfs.bpfFilters = make(map[string]int)
+		}
+		kfs.bpfFilters[filterString]++
+	}
+}
+
+func registerKernelLoadEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.KernelLoadEventFilter) {
+	kfs := kernelLoadFilterSet{}
+	for _, kef := range events {
+		kfs.add(kef)
+	}
+
+	f := kernelLoadFilter{
+		sensor: sensor,
+	}
+
+	// Both init_module(2) and finit_module(2) end up in load_module(),
+	// which fires this tracepoint once the module name is known.
+	registerEvent(sensor.monitor, eventMap, kernelLoadModuleTracepoint, f.decodeModuleLoad, kfs.moduleFilters)
+	registerKprobe(sensor.monitor, eventMap, kernelLoadBPFKprobeSymbol, kernelLoadBPFKprobeFetchargs, f.decodeSysBPF, kfs.bpfFilters)
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+)
+
+func TestKernelLoadDecoders(t *testing.T) {
+	s := &Sensor{}
+	s.processCache.cache = newMapTaskCache()
+	f := kernelLoadFilter{sensor: s}
+	sample := &perf.SampleRecord{}
+
+	e, _ := f.decodeModuleLoad(sample, perf.TraceEventSampleData{
+		"common_pid": int32(1),
+		"name":       "rootkit",
+		"taints":     uint32(0x1000),
+	})
+	kev := e.(*api.Event).GetKernelLoad()
+	if kev.Type != api.KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_MODULE ||
+		kev.ModuleName != "rootkit" || kev.ModuleTaints != 0x1000 {
+		t.Errorf("Unexpected module load event %+v", kev)
+	}
+
+	e, _ = f.decodeSysBPF(sample, perf.TraceEventSampleData{
+		"common_pid": int32(1),
+		"cmd":        int32(bpfProgLoad),
+		"prog_type":  uint32(2),
+		"insn_cnt":   uint32(64),
+		"prog_name":  "probe",
+	})
+	kev = e.(*api.Event).GetKernelLoad()
+	if kev.Type != api.KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_BPF ||
+		kev.BpfCmd != bpfProgLoad || kev.BpfProgType != 2 ||
+		kev.BpfInsnCnt != 64 || kev.BpfProgName != "probe" {
+		t.Errorf("Unexpected BPF_PROG_LOAD event %+v", kev)
+	}
+
+	// Only BPF_PROG_LOAD reads the program fields from bpf_attr
+	e, _ = f.decodeSysBPF(sample, perf.TraceEventSampleData{
+		"common_pid": int32(1),
+		"cmd":        int32(0),
+		"prog_type":  uint32(2),
+		"insn_cnt":   uint32(64),
+		"prog_name":  "garbage",
+	})
+	kev = e.(*api.Event).GetKernelLoad()
+	if kev.BpfCmd != 0 || kev.BpfProgType != 0 ||
+		kev.BpfInsnCnt != 0 || kev.BpfProgName != "" {
+		t.Errorf("Unexpected BPF_MAP_CREATE event %+v", kev)
+	}
+}
+
+func TestKernelLoadFilterSet(t *testing.T) {
+	kfs := kernelLoadFilterSet{}
+	kfs.add(&api.KernelLoadEventFilter{
+		Type: api.KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_MODULE,
+	})
+	kfs.add(&api.KernelLoadEventFilter{
+		Type: api.KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_MODULE,
+		FilterExpression: expression.Equal(
+			expression.Identifier("name"),
+			expression.Value("rootkit")),
+	})
+	kfs.add(&api.KernelLoadEventFilter{
+		Type: api.KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_BPF,
+		FilterExpression: expression.Equal(
+			expression.Identifier("cmd"),
+			expression.Value(int32(bpfProgLoad))),
+	})
+
+	// Invalid kernel filters are dropped
+	kfs.add(&api.KernelLoadEventFilter{
+		Type: api.KernelLoadEventType_KERNEL_LOAD_EVENT_TYPE_BPF,
+		FilterExpression: expression.IsNull(
+			expression.Identifier("prog_name")),
+	})
+
+	if len(kfs.moduleFilters) != 2 || kfs.moduleFilters[""] != 1 ||
+		kfs.moduleFilters[`name == "rootkit"`] != 1 {
+		t.Errorf("Unexpected module filters %v", kfs.moduleFilters)
+	}
+	if len(kfs.bpfFilters) != 1 || kfs.bpfFilters["cmd == 5"] != 1 {
+		t.Errorf("Unexpected BPF filters %v", kfs.bpfFilters)
+	}
+}
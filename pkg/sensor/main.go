@@ -23,12 +23,20 @@ import (
 // Main is the main entrypoint for the sensor
 func Main() {
 	manager := services.NewServiceManager()
-	if len(config.Global.ProfilingAddr) > 0 {
-		service := services.NewProfilingService(
-			config.Global.ProfilingAddr)
-		manager.RegisterService(service)
+
+	adminAddr := config.Global.AdminAddr
+	if len(adminAddr) == 0 {
+		adminAddr = config.Global.ProfilingAddr
+	}
+	var admin *services.AdminService
+	if len(adminAddr) > 0 {
+		admin = services.NewAdminService(adminAddr)
+		manager.RegisterService(admin)
 	}
-	if len(config.Sensor.ServerAddr) > 0 {
+
+	if len(config.Sensor.ServerAddr) > 0 ||
+		len(config.Sensor.HTTPServerAddr) > 0 {
+
 		sensor, err := NewSensor()
 		if err != nil {
 			glog.Fatalf("Could not create sensor: %s", err.Error())
@@ -37,8 +45,22 @@ func Main() {
 			glog.Fatalf("Could not start sensor: %s", err.Error())
 		}
 		defer sensor.Stop()
-		service := NewTelemetryService(sensor, config.Sensor.ServerAddr)
-		manager.RegisterService(service)
+
+		if admin != nil {
+			admin.AddHealthCheck(sensor.checkHealth)
+			admin.AddMetrics(sensor.writeMetrics)
+		}
+
+		if len(config.Sensor.ServerAddr) > 0 {
+			service := NewTelemetryService(sensor,
+				config.Sensor.ServerAddr)
+			manager.RegisterService(service)
+		}
+		if len(config.Sensor.HTTPServerAddr) > 0 {
+			service := NewHTTPTelemetryService(sensor,
+				config.Sensor.HTTPServerAddr)
+			manager.RegisterService(service)
+		}
 	}
 
 	manager.Run()
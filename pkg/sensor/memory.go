@@ -0,0 +1,232 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/golang/glog"
+)
+
+const (
+	memoryMprotectKprobeSymbol     = "sys_mprotect"
+	memoryPkeyMprotectKprobeSymbol = "sys_pkey_mprotect"
+	memoryMprotectKprobeFetchargs  = "address=%di:u64 length=%si:u64 prot=%dx:s32"
+
+	memoryPtraceKprobeSymbol    = "sys_ptrace"
+	memoryPtraceKprobeFetchargs = "request=%di:s64 pid=%si:s32 addr=%dx:u64"
+
+	// The first element of the remote_iov array passed to
+	// process_vm_writev(2) is a struct iovec, whose iov_base is first.
+	memoryProcessVMWritevKprobeSymbol    = "sys_process_vm_writev"
+	memoryProcessVMWritevKprobeFetchargs = "pid=%di:s32 remote_address=+0(%cx):u64 riovcnt=%r8:u64"
+
+	// PROT_EXEC from include/uapi/asm-generic/mman-common.h
+	protExec = 0x4
+)
+
+// ptraceRequests are the ptrace(2) requests reported as PTRACE events:
+// those that attach to a process or write to its memory or registers.
+// Values are from include/uapi/linux/ptrace.h and
+// arch/x86/include/uapi/asm/ptrace-abi.h.
+var ptraceRequests = []int{
+	4,      // PTRACE_POKETEXT
+	5,      // PTRACE_POKEDATA
+	6,      // PTRACE_POKEUSER
+	13,     // PTRACE_SETREGS
+	16,     // PTRACE_ATTACH
+	0x4205, // PTRACE_SETREGSET
+	0x4206, // PTRACE_SEIZE
+}
+
+func ptraceFilterString() string {
+	parts := make([]string, len(ptraceRequests))
+	for i, r := range ptraceRequests {
+		parts[i] = fmt.Sprintf("request == %d", r)
+	}
+	return strings.Join(parts, " || ")
+}
+
+type memoryFilter struct {
+	sensor *Sensor
+
+	// The host's pid namespace, or "" if it couldn't be determined
+	hostPidNamespace string
+}
+
+// targetProcessID returns the process id of the process targeted by the
+// caller identified by hostPid. The target's pid is in the caller's pid
+// namespace, so it can only be looked up in the process cache, which is
+// keyed by host pid, when the caller is in the host's pid namespace.
+func (f *memoryFilter) targetProcessID(hostPid, pid int32) string {
+	procFS := sys.HostProcFS()
+	if procFS == nil || len(f.hostPidNamespace) == 0 {
+		return ""
+	}
+	ns, err := procFS.PidNamespace(int(hostPid))
+	if err != nil || ns != f.hostPidNamespace {
+		return ""
+	}
+
+	processID, _ := f.sensor.processCache.ProcessID(int(pid))
+	return processID
+}
+
+func (f *memoryFilter) decodeMprotect(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_Memory{
+		Memory: &api.MemoryEvent{
+			Type:    api.MemoryEventType_MEMORY_EVENT_TYPE_MPROTECT_EXEC,
+			Address: data["address"].(uint64),
+			Length:  data["length"].(uint64),
+			Prot:    data["prot"].(int32),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *memoryFilter) decodePtrace(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	pid := data["pid"].(int32)
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_Memory{
+		Memory: &api.MemoryEvent{
+			Type:            api.MemoryEventType_MEMORY_EVENT_TYPE_PTRACE,
+			TargetPid:       pid,
+			TargetProcessId: f.targetProcessID(data["common_pid"].(int32), pid),
+			PtraceRequest:   data["request"].(int64),
+			PtraceAddress:   data["addr"].(uint64),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *memoryFilter) decodeProcessVMWritev(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	pid := data["pid"].(int32)
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_Memory{
+		Memory: &api.MemoryEvent{
+			Type:            api.MemoryEventType_MEMORY_EVENT_TYPE_PROCESS_VM_WRITEV,
+			TargetPid:       pid,
+			TargetProcessId: f.targetProcessID(data["common_pid"].(int32), pid),
+			RemoteAddress:   data["remote_address"].(uint64),
+			RemoteIovCount:  data["riovcnt"].(uint64),
+		},
+	}
+
+	return ev, nil
+}
+
+type memoryFilterSet struct {
+	mprotectFilters map[string]int
+	ptraceFilters   map[string]int
+	writevFilters   map[string]int
+}
+
+func (mfs *memoryFilterSet) add(mef *api.MemoryEventFilter) {
+	var filterString string
+
+	if mef.FilterExpression != nil {
+		expr, err := expression.NewExpression(mef.FilterExpression)
+		if err != nil {
+			glog.V(1).Infof("Bad memory filter expression: %s", err)
+			return
+		}
+
+		err = expr.ValidateKernelFilter()
+		if err != nil {
+			glog.V(1).Infof("Bad memory filter expression: %s", err)
+			return
+		}
+
+		filterString = expr.KernelFilterString()
+	}
+
+	switch mef.Type {
+	case api.MemoryEventType_MEMORY_EVENT_TYPE_MPROTECT_EXEC:
+		if mfs.mprotectFilters == nil {
+			mfs.mprotectFilters = make(map[string]int)
+		}
+		mfs.mprotectFilters[filterString]++
+	case api.MemoryEventType_MEMORY_EVENT_TYPE_PTRACE:
+		if mfs.ptraceFilters == nil {
+			mfs.ptraceFilters = make(map[string]int)
+		}
+		mfs.ptraceFilters[filterString]++
+	case api.MemoryEventType_MEMORY_EVENT_TYPE_PROCESS_VM_WRITEV:
+		if mfs.writevFilters == nil {
+			mfs.writevFilters = make(map[string]int)
+		}
+		mfs.writevFilters[filterString]++
+	}
+}
+
+func registerMemoryKprobe(sensor *Sensor, eventMap subscriptionMap, symbol string, fetchargs string, fn perf.TraceEventDecoderFn, builtin string, filters map[string]int) {
+	f, active := combinedFilterString(builtin, filters)
+	if !active {
+		return
+	}
+
+	eventID, err := sensor.monitor.RegisterKprobe(symbol, false, fetchargs,
+		fn, perf.WithFilter(f))
+	if err != nil {
+		glog.V(1).Infof("Couldn't register memory kprobe %s: %v",
+			symbol, err)
+		return
+	}
+
+	eventMap[eventID] = &subscription{}
+}
+
+func registerMemoryEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.MemoryEventFilter) {
+	mfs := memoryFilterSet{}
+	for _, mef := range events {
+		mfs.add(mef)
+	}
+
+	f := memoryFilter{
+		sensor: sensor,
+	}
+	if procFS := sys.HostProcFS(); procFS != nil {
+		f.hostPidNamespace, _ = procFS.PidNamespace(1)
+	}
+
+	// A region's current protection is part of the kernel's vma, which
+	// a kprobe can't reach portably, so every PROT_EXEC request is seen.
+	mprotectFilter := fmt.Sprintf("prot & %d", protExec)
+	registerMemoryKprobe(sensor, eventMap, memoryMprotectKprobeSymbol,
+		memoryMprotectKprobeFetchargs, f.decodeMprotect, mprotectFilter,
+		mfs.mprotectFilters)
+	registerMemoryKprobe(sensor, eventMap, memoryPkeyMprotectKprobeSymbol,
+		memoryMprotectKprobeFetchargs, f.decodeMprotect, mprotectFilter,
+		mfs.mprotectFilters)
+
+	registerMemoryKprobe(sensor, eventMap, memoryPtraceKprobeSymbol,
+		memoryPtraceKprobeFetchargs, f.decodePtrace, ptraceFilterString(),
+		mfs.ptraceFilters)
+
+	registerMemoryKprobe(sensor, eventMap, memoryProcessVMWritevKprobeSymbol,
+		memoryProcessVMWritevKprobeFetchargs, f.decodeProcessVMWritev, "",
+		mfs.writevFilters)
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"strings"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+)
+
+func TestPtraceFilterString(t *testing.T) {
+	s := ptraceFilterString()
+	for _, want := range []string{"request == 4", "request == 16", "request == 16902"} {
+		if !strings.Contains(s, want) {
+			t.Errorf("Expected %q in %q", want, s)
+		}
+	}
+}
+
+func TestMemoryFilterSet(t *testing.T) {
+	mfs := memoryFilterSet{}
+	mfs.add(&api.MemoryEventFilter{
+		Type: api.MemoryEventType_MEMORY_EVENT_TYPE_PTRACE,
+	})
+
+	if _, ok := combinedFilterString("prot & 4", mfs.mprotectFilters); ok {
+		t.Error("Expected no mprotect filter")
+	}
+	if _, ok := combinedFilterString("", mfs.writevFilters); ok {
+		t.Error("Expected no process_vm_writev filter")
+	}
+
+	s, ok := combinedFilterString(ptraceFilterString(), mfs.ptraceFilters)
+	if !ok || s != ptraceFilterString() {
+		t.Errorf("Expected ptrace filter %q, got %q", ptraceFilterString(), s)
+	}
+}
+
+func TestDecodePtrace(t *testing.T) {
+	s := &Sensor{}
+	s.processCache.cache = newMapTaskCache()
+
+	// The target pid can't be resolved without the host's pid namespace
+	f := memoryFilter{sensor: s}
+	e, _ := f.decodePtrace(&perf.SampleRecord{}, perf.TraceEventSampleData{
+		"common_pid": int32(1),
+		"request":    int64(16),
+		"pid":        int32(7),
+		"addr":       uint64(0),
+	})
+	mev := e.(*api.Event).GetMemory()
+	if mev.Type != api.MemoryEventType_MEMORY_EVENT_TYPE_PTRACE ||
+		mev.TargetPid != 7 || mev.PtraceRequest != 16 {
+		t.Errorf("Unexpected ptrace event %+v", mev)
+	}
+	if len(mev.TargetProcessId) != 0 {
+		t.Errorf("Expected no target process id, got %q", mev.TargetProcessId)
+	}
+}
+
+func TestMprotectWritableFilter(t *testing.T) {
+	mfs := memoryFilterSet{}
+	mfs.add(&api.MemoryEventFilter{
+		Type: api.MemoryEventType_MEMORY_EVENT_TYPE_MPROTECT_EXEC,
+		FilterExpression: expression.NotEqual(
+			expression.BitwiseAnd(
+				expression.Identifier("prot"),
+				expression.Value(int32(2))),
+			expression.Value(int32(0))),
+	})
+
+	s, ok := combinedFilterString("prot & 4", mfs.mprotectFilters)
+	if want := "(prot & 4) && ((prot & 2))"; !ok || s != want {
+		t.Errorf("Expected mprotect filter %q, got %q", want, s)
+	}
+}
@@ -14,11 +14,341 @@
 
 package sensor
 
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/config"
+	"github.com/capsule8/capsule8/pkg/services"
+	"github.com/capsule8/capsule8/pkg/stream"
+	"github.com/golang/glog"
+
+	"golang.org/x/sys/unix"
+)
+
 // MetricsCounters is used for tracking metrics information in the sensor
 type MetricsCounters struct {
 	// Number of events created during the sample period
 	Events uint64
 
-	// Number of subscriptions
+	// Number of subscriptions made since the sensor started
 	Subscriptions int32
 }
+
+// lostEventCounts accumulates the number of events lost because the
+// sensor's perf ring buffers overflowed.
+type lostEventCounts struct {
+	sync.Mutex
+	total    uint64
+	bySource map[string]uint64
+	byCPU    map[int32]uint64
+}
+
+func (c *lostEventCounts) add(source string, cpu int32, n uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.bySource == nil {
+		c.bySource = make(map[string]uint64)
+		c.byCPU = make(map[int32]uint64)
+	}
+
+	c.total += n
+	c.bySource[source] += n
+	c.byCPU[cpu] += n
+}
+
+// fill copies the current counts into the given SensorMetricsEvent
+func (c *lostEventCounts) fill(me *api.SensorMetricsEvent) {
+	c.Lock()
+	defer c.Unlock()
+
+	me.LostEvents = c.total
+	if c.total == 0 {
+		return
+	}
+
+	me.LostEventsBySource = make(map[string]uint64, len(c.bySource))
+	for k, v := range c.bySource {
+		me.LostEventsBySource[k] = v
+	}
+	me.LostEventsByCpu = make(map[int32]uint64, len(c.byCPU))
+	for k, v := range c.byCPU {
+		me.LostEventsByCpu[k] = v
+	}
+}
+
+// checkHealth reports whether the sensor is able to deliver kernel events,
+// for the admin endpoint's /healthz.
+func (s *Sensor) checkHealth() error {
+	if atomic.LoadInt32(&s.monitorRunning) == 0 {
+		return errors.New("Sensor event monitor is not running")
+	}
+	return nil
+}
+
+// writeMetrics writes the sensor's counters and most recent resource
+// usage measurement for the admin endpoint's /metrics.
+func (s *Sensor) writeMetrics(mw *services.MetricsWriter) {
+	mw.Counter("capsule8_sensor_events_total",
+		"Number of events created by the Sensor.",
+		float64(atomic.LoadUint64(&s.Metrics.Events)))
+	mw.Counter("capsule8_sensor_subscriptions_total",
+		"Number of subscriptions made since the Sensor started.",
+		float64(atomic.LoadInt32(&s.Metrics.Subscriptions)))
+
+	me := &api.SensorMetricsEvent{}
+	s.lostEvents.fill(me)
+	mw.Counter("capsule8_sensor_lost_events_total",
+		"Number of kernel events lost because a perf ring buffer overflowed.",
+		float64(me.LostEvents))
+	bySource := make(map[string]float64, len(me.LostEventsBySource))
+	for source, n := range me.LostEventsBySource {
+		bySource[source] = float64(n)
+	}
+	mw.CounterVec("capsule8_sensor_lost_events_by_source_total",
+		"Number of kernel events lost, by event source.", "source",
+		bySource)
+
+	usage := s.selfMonitor.metrics()
+	mw.Gauge("capsule8_sensor_cpu_percent",
+		"CPU usage of the Sensor at its last measurement, as a percentage of a single CPU.",
+		usage.CpuPercent)
+	mw.Gauge("capsule8_sensor_rss_bytes",
+		"Resident memory usage of the Sensor at its last measurement.",
+		float64(usage.RssBytes))
+}
+
+// Once shedding has started, usage must fall below this fraction of the
+// budget before shed event sources are restored. This keeps the sensor from
+// flapping between shedding and restoring at every measurement.
+const budgetRestoreFraction = 0.8
+
+// selfMonitor periodically measures the sensor's own resource usage. When
+// the sensor is over its configured budget, the kernel events of
+// low-priority event sources are disabled until usage falls back under
+// budget.
+type selfMonitor struct {
+	sensor   *Sensor
+	interval time.Duration
+	done     chan struct{}
+
+	// Waited on by stop until the monitor goroutine has exited
+	wg sync.WaitGroup
+
+	// The most recent measurement (*api.SensorMetricsEvent)
+	latest atomic.Value
+
+	// Only accessed from the monitor goroutine
+	lastTime   time.Time
+	lastCPU    time.Duration
+	lastEvents uint64
+	shedding   bool
+	shed       map[uint64]struct{}
+}
+
+// metricsMinInterval is the shortest interval at which the sensor measures
+// its own resource usage or reports it to subscribers.
+const metricsMinInterval = 100 * time.Millisecond
+
+func newSelfMonitor(sensor *Sensor, interval time.Duration) *selfMonitor {
+	if interval < metricsMinInterval {
+		interval = metricsMinInterval
+	}
+
+	m := &selfMonitor{
+		sensor:   sensor,
+		interval: interval,
+		done:     make(chan struct{}),
+		shed:     make(map[uint64]struct{}),
+	}
+	m.latest.Store(&api.SensorMetricsEvent{})
+
+	return m
+}
+
+func (m *selfMonitor) start() {
+	m.lastTime = time.Now()
+	m.lastCPU = processCPUTime()
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		t := time.NewTicker(m.interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-m.done:
+				return
+			case now := <-t.C:
+				me := m.measure(now)
+				m.enforceBudget(me)
+				m.latest.Store(me)
+			}
+		}
+	}()
+}
+
+// stop stops the monitor goroutine and waits for it to exit, so that it
+// no longer uses the sensor's EventMonitor once stop returns.
+func (m *selfMonitor) stop() {
+	close(m.done)
+	m.wg.Wait()
+}
+
+// metrics returns the most recent measurement of the sensor's resource usage
+func (m *selfMonitor) metrics() *api.SensorMetricsEvent {
+	me := *m.latest.Load().(*api.SensorMetricsEvent)
+	return &me
+}
+
+func (m *selfMonitor) measure(now time.Time) *api.SensorMetricsEvent {
+	cpu := processCPUTime()
+	events := atomic.LoadUint64(&m.sensor.Metrics.Events)
+	elapsed := now.Sub(m.lastTime)
+
+	me := &api.SensorMetricsEvent{
+		RssBytes:      processRSS(),
+		Subscriptions: atomic.LoadInt32(&m.sensor.Metrics.Subscriptions),
+	}
+	m.sensor.lostEvents.fill(me)
+	if elapsed > 0 {
+		me.CpuPercent = 100 * float64(cpu-m.lastCPU) / float64(elapsed)
+		me.EventsPerSecond = float64(events-m.lastEvents) /
+			elapsed.Seconds()
+	}
+
+	m.lastTime = now
+	m.lastCPU = cpu
+	m.lastEvents = events
+
+	return me
+}
+
+// overBudget reports whether the given usage exceeds the configured budgets
+// scaled by the given fraction.
+func overBudget(me *api.SensorMetricsEvent, fraction float64) bool {
+	cpuBudget := config.Sensor.CPUBudget * fraction
+	if cpuBudget > 0 && me.CpuPercent > cpuBudget {
+		return true
+	}
+
+	memoryBudget := float64(config.Sensor.MemoryBudget) * fraction
+	if memoryBudget > 0 && float64(me.RssBytes) > memoryBudget {
+		return true
+	}
+
+	return false
+}
+
+func (m *selfMonitor) enforceBudget(me *api.SensorMetricsEvent) {
+	if !m.shedding && overBudget(me, 1) {
+		glog.Warningf("Sensor over budget (CPU %.1f%%, RSS %d bytes), shedding %s events",
+			me.CpuPercent, me.RssBytes,
+			strings.Join(config.Sensor.ShedSources, ", "))
+		m.shedding = true
+	} else if m.shedding && !overBudget(me, budgetRestoreFraction) {
+		glog.Infof("Sensor back under budget, restoring shed events")
+		m.shedding = false
+	}
+
+	eventMap := m.sensor.eventMap.getMap()
+	monitor := m.sensor.monitor
+
+	// Forget about events that have been unregistered
+	for eventID := range m.shed {
+		if _, ok := eventMap[eventID]; !ok {
+			delete(m.shed, eventID)
+		}
+	}
+
+	if m.shedding {
+		// Event sources may have been subscribed to since the last
+		// measurement, so look for new events to shed every time.
+		for eventID, sub := range eventMap {
+			if _, ok := m.shed[eventID]; ok || !isShedSource(sub.source) {
+				continue
+			}
+			monitor.Disable(eventID)
+			m.shed[eventID] = struct{}{}
+		}
+	} else {
+		for eventID := range m.shed {
+			monitor.Enable(eventID)
+			delete(m.shed, eventID)
+		}
+	}
+
+	me.OverBudget = m.shedding
+	me.ShedKernelEvents = uint32(len(m.shed))
+}
+
+func isShedSource(source string) bool {
+	for _, s := range config.Sensor.ShedSources {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
+// processCPUTime returns the user and system CPU time used by the sensor
+func processCPUTime() time.Duration {
+	var ru unix.Rusage
+	if err := unix.Getrusage(unix.RUSAGE_SELF, &ru); err != nil {
+		return 0
+	}
+
+	return time.Duration(ru.Utime.Sec+ru.Stime.Sec)*time.Second +
+		time.Duration(ru.Utime.Usec+ru.Stime.Usec)*time.Microsecond
+}
+
+// processRSS returns the resident memory used by the sensor in bytes
+func processRSS() uint64 {
+	b, err := ioutil.ReadFile("/proc/self/statm")
+	if err != nil {
+		return 0
+	}
+
+	fields := strings.Fields(string(b))
+	if len(fields) < 2 {
+		return 0
+	}
+
+	pages, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return pages * uint64(os.Getpagesize())
+}
+
+func newMetricsSource(sensor *Sensor, filter *api.SensorMetricsEventFilter) (*stream.Stream, error) {
+	interval := time.Duration(filter.Interval)
+	if interval <= 0 {
+		interval = sensor.selfMonitor.interval
+	} else if interval < metricsMinInterval {
+		interval = metricsMinInterval
+	}
+
+	ticker := stream.Ticker(interval)
+	metrics := stream.Map(ticker, func(e interface{}) interface{} {
+		ev := sensor.NewEvent()
+		ev.Event = &api.Event_Metrics{
+			Metrics: sensor.selfMonitor.metrics(),
+		}
+		return ev
+	})
+
+	return metrics, nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/config"
+	"github.com/capsule8/capsule8/pkg/services"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+)
+
+func TestOverBudget(t *testing.T) {
+	cpuBudget, memoryBudget := config.Sensor.CPUBudget, config.Sensor.MemoryBudget
+	defer func() {
+		config.Sensor.CPUBudget = cpuBudget
+		config.Sensor.MemoryBudget = memoryBudget
+	}()
+
+	me := &api.SensorMetricsEvent{
+		CpuPercent: 9,
+		RssBytes:   100 << 20,
+	}
+
+	config.Sensor.CPUBudget = 0
+	config.Sensor.MemoryBudget = 0
+	if overBudget(me, 1) {
+		t.Error("Expected no budget to be enforced when budgets are 0")
+	}
+
+	config.Sensor.CPUBudget = 10
+	if overBudget(me, 1) {
+		t.Error("Expected 9% CPU to be under a 10% budget")
+	}
+	if !overBudget(me, budgetRestoreFraction) {
+		t.Error("Expected 9% CPU to be over 80% of a 10% budget")
+	}
+
+	config.Sensor.CPUBudget = 0
+	config.Sensor.MemoryBudget = 50 << 20
+	if !overBudget(me, 1) {
+		t.Error("Expected 100MB RSS to be over a 50MB budget")
+	}
+}
+
+func TestSelfMonitorMeasure(t *testing.T) {
+	s := &Sensor{}
+	m := newSelfMonitor(s, time.Second)
+	m.lastTime = time.Now()
+	m.lastCPU = processCPUTime()
+
+	s.Metrics.Events = 50
+	me := m.measure(m.lastTime.Add(10 * time.Second))
+	if me.EventsPerSecond != 5 {
+		t.Errorf("Expected 5 events per second, got %v", me.EventsPerSecond)
+	}
+	if me.RssBytes == 0 {
+		t.Error("Expected non-zero RSS")
+	}
+	if me.CpuPercent < 0 {
+		t.Errorf("Expected non-negative CPU usage, got %v", me.CpuPercent)
+	}
+}
+
+func TestMetricsMinInterval(t *testing.T) {
+	s := &Sensor{}
+	s.selfMonitor = newSelfMonitor(s, 0)
+	if s.selfMonitor.interval != metricsMinInterval {
+		t.Errorf("Expected interval to be raised to %s, got %s",
+			metricsMinInterval, s.selfMonitor.interval)
+	}
+
+	start := time.Now()
+	metrics, err := newMetricsSource(s, &api.SensorMetricsEventFilter{
+		Interval: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer metrics.Close()
+
+	<-metrics.Data
+	<-metrics.Data
+	if elapsed := time.Since(start); elapsed < metricsMinInterval {
+		t.Errorf("Expected reports at least %s apart, got 2 in %s",
+			metricsMinInterval, elapsed)
+	}
+}
+
+func TestSelfMonitorStop(t *testing.T) {
+	s := &Sensor{
+		eventMap: newSafeSubscriptionMap(),
+	}
+
+	// Stopping a monitor that was never started must not block
+	newSelfMonitor(s, 0).stop()
+
+	s.selfMonitor = newSelfMonitor(s, 0)
+	s.selfMonitor.start()
+	time.Sleep(2 * metricsMinInterval)
+	s.selfMonitor.stop()
+
+	// No measurements may be taken once stop has returned
+	latest := s.selfMonitor.latest.Load()
+	time.Sleep(2 * metricsMinInterval)
+	if s.selfMonitor.latest.Load() != latest {
+		t.Error("Expected no measurements after stop")
+	}
+}
+
+func TestWriteMetrics(t *testing.T) {
+	s := &Sensor{}
+	s.selfMonitor = newSelfMonitor(s, time.Second)
+	s.Metrics.Events = 7
+	s.Metrics.Subscriptions = 2
+
+	var buf bytes.Buffer
+	s.writeMetrics(services.NewMetricsWriter(&buf))
+
+	metrics := buf.String()
+	for _, want := range []string{
+		"# TYPE capsule8_sensor_events_total counter\n",
+		"capsule8_sensor_events_total 7\n",
+		"# TYPE capsule8_sensor_subscriptions_total counter\n",
+		"capsule8_sensor_subscriptions_total 2\n",
+	} {
+		if !strings.Contains(metrics, want) {
+			t.Errorf("Expected %q in metrics:\n%s", want, metrics)
+		}
+	}
+}
+
+func TestDispatchLostRecord(t *testing.T) {
+	s := &Sensor{
+		eventMap: newSafeSubscriptionMap(),
+	}
+	s.selfMonitor = newSelfMonitor(s, time.Second)
+
+	data := make(chan interface{}, 2)
+	s.eventMap.update(subscriptionMap{
+		1: &subscription{data: data, source: "syscall"},
+		2: &subscription{data: data, source: "network"},
+	})
+
+	s.dispatchSample(1, &perf.Sample{
+		Record:   &perf.LostRecord{Lost: 5},
+		SampleID: perf.SampleID{CPU: 3},
+	}, nil)
+
+	if len(data) != 1 {
+		t.Fatalf("Expected one lost events notification, got %d", len(data))
+	}
+	e := (<-data).(*api.Event)
+	le := e.GetLostEvents()
+	if le == nil || le.Count != 5 || le.Source != "syscall" || e.Cpu != 3 {
+		t.Errorf("Unexpected lost events notification %+v", e)
+	}
+
+	me := s.selfMonitor.measure(time.Now())
+	if me.LostEvents != 5 || me.LostEventsBySource["syscall"] != 5 ||
+		me.LostEventsByCpu[3] != 5 {
+		t.Errorf("Unexpected lost event counts %+v", me)
+	}
+}
@@ -36,8 +36,23 @@ const (
 	networkKprobeSendmsgSymbol    = "sys_sendmsg"
 	networkKprobeSendmsgFetchargs = "fd=%di sa_family=+0(+0(%si)):u16 sin_port=+2(+0(%si)):u16 sin_addr=+4(+0(%si)):u32 sun_path=+2(+0(%si)):string sin6_port=+2(+0(%si)):u16 sin6_addr_high=+8(+0(%si)):u64 sin6_addr_low=+16(+0(%si)):u64"
 
+	// Only the first message passed to sendmmsg is inspected. Each
+	// struct mmsghdr begins with a struct msghdr, so sendmsg's fetchargs
+	// apply to it.
+	networkKprobeSendmmsgSymbol = "sys_sendmmsg"
+
 	networkKprobeSendtoSymbol    = "sys_sendto"
 	networkKprobeSendtoFetchargs = "fd=%di sa_family=+0(%r8):u16 sin_port=+2(%r8):u16 sin_addr=+4(%r8):u32 sun_path=+2(%r8):string sin6_port=+2(%r8):u16 sin6_addr_high=+8(%r8):u64 sin6_addr_low=+16(%r8):u64"
+
+	// DNS queries are recognized by their destination port. The QNAME
+	// immediately follows the 12 byte DNS header and is terminated by a
+	// zero-length label, so it can be fetched as a string.
+	networkKprobeDNSSendmsgFetchargs = "fd=%di sa_family=+0(+0(%si)):u16 sin_port=+2(+0(%si)):u16 sin_addr=+4(+0(%si)):u32 sin6_port=+2(+0(%si)):u16 sin6_addr_high=+8(+0(%si)):u64 sin6_addr_low=+16(+0(%si)):u64 qname=+12(+0(+16(%si))):string"
+	networkKprobeDNSSendtoFetchargs  = "fd=%di sa_family=+0(%r8):u16 sin_port=+2(%r8):u16 sin_addr=+4(%r8):u32 sin6_port=+2(%r8):u16 sin6_addr_high=+8(%r8):u64 sin6_addr_low=+16(%r8):u64 qname=+12(%si):string"
+
+	// Port 53 in network byte order as read by a u16 fetcharg on x86_64.
+	// sin_port and sin6_port share the same offset.
+	networkDNSPortFilter = "(sa_family == 2 || sa_family == 10) && sin_port == 13568"
 )
 
 type networkFilter struct {
@@ -187,6 +202,37 @@ func (f *networkFilter) decodeSysExitSendto(sample *perf.SampleRecord, data perf
 	return event, nil
 }
 
+func (f *networkFilter) decodeDNSQuery(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	event := f.newNetworkEvent(api.NetworkEventType_NETWORK_EVENT_TYPE_DNS_QUERY, sample, data)
+	if event == nil {
+		return nil, nil
+	}
+
+	network := event.Event.(*api.Event_Network).Network
+	network.DnsQueryName = decodeDNSName(data["qname"].(string))
+
+	return event, nil
+}
+
+// decodeDNSName converts a DNS wire format name (a sequence of length-prefixed
+// labels) into its dotted representation. The terminating zero-length label
+// is not included in data fetched by the kernel as a string.
+func decodeDNSName(qname string) string {
+	labels := make([]string, 0, 4)
+	for i := 0; i < len(qname); {
+		n := int(qname[i])
+		i++
+		if n == 0 || n > 63 || i+n > len(qname) {
+			// Compression pointers and truncated labels are not
+			// valid in a query's question section.
+			break
+		}
+		labels = append(labels, qname[i:i+n])
+		i += n
+	}
+	return strings.Join(labels, ".")
+}
+
 type networkFilterSet struct {
 	acceptAttemptFilters   map[string]int
 	acceptResultFilters    map[string]int
@@ -200,6 +246,7 @@ type networkFilterSet struct {
 	sendtoResultFilters    map[string]int
 	recvfromAttemptFilters map[string]int
 	recvfromResultFilters  map[string]int
+	dnsQueryFilters        map[string]int
 }
 
 func (nfs *networkFilterSet) add(nef *api.NetworkEventFilter) {
@@ -276,6 +323,17 @@ func (nfs *networkFilterSet) add(nef *api.NetworkEventFilter) {
 			nfs.sendtoResultFilters = make(map[string]int)
 		}
 		nfs.sendtoResultFilters[filterString]++
+	case api.NetworkEventType_NETWORK_EVENT_TYPE_DNS_QUERY:
+		if nfs.dnsQueryFilters == nil {
+			nfs.dnsQueryFilters = make(map[string]int)
+		}
+		if len(filterString) > 0 {
+			filterString = fmt.Sprintf("%s && (%s)",
+				networkDNSPortFilter, filterString)
+		} else {
+			filterString = networkDNSPortFilter
+		}
+		nfs.dnsQueryFilters[filterString]++
 	}
 }
 
@@ -299,6 +357,22 @@ func fullFilterString(filters map[string]int) (string, bool) {
 	return "", false
 }
 
+// combinedFilterString is the same as fullFilterString, but restricts the
+// result to events matching the builtin filter, if there is one.
+func combinedFilterString(builtin string, filters map[string]int) (string, bool) {
+	f, active := fullFilterString(filters)
+	if !active {
+		return "", false
+	}
+	if len(builtin) == 0 {
+		return f, true
+	}
+	if len(f) == 0 {
+		return builtin, true
+	}
+	return fmt.Sprintf("(%s) && (%s)", builtin, f), true
+}
+
 func registerEvent(monitor *perf.EventMonitor, eventMap subscriptionMap, name string, fn perf.TraceEventDecoderFn, filters map[string]int) {
 	f, active := fullFilterString(filters)
 	if !active {
@@ -367,4 +441,11 @@ func registerNetworkEvents(sensor *Sensor, eventMap subscriptionMap, events []*a
 
 	registerEvent(sensor.monitor, eventMap, "syscalls/sys_exit_sendmsg", f.decodeSysExitSendto, nfs.sendtoResultFilters)
 	registerEvent(sensor.monitor, eventMap, "syscalls/sys_exit_sendto", f.decodeSysExitSendto, nfs.sendtoResultFilters)
+
+	// DNS queries sent on connected sockets carry no destination address
+	// in the system call, and the socket's peer can't be fetched from its
+	// file descriptor by a kprobe, so they are not reported.
+	registerKprobe(sensor.monitor, eventMap, networkKprobeSendmsgSymbol, networkKprobeDNSSendmsgFetchargs, f.decodeDNSQuery, nfs.dnsQueryFilters)
+	registerKprobe(sensor.monitor, eventMap, networkKprobeSendmmsgSymbol, networkKprobeDNSSendmsgFetchargs, f.decodeDNSQuery, nfs.dnsQueryFilters)
+	registerKprobe(sensor.monitor, eventMap, networkKprobeSendtoSymbol, networkKprobeDNSSendtoFetchargs, f.decodeDNSQuery, nfs.dnsQueryFilters)
 }
@@ -0,0 +1,64 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+)
+
+func TestDecodeDNSName(t *testing.T) {
+	tests := map[string]string{
+		"\x03www\x07example\x03com": "www.example.com",
+		"\x09localhost":             "localhost",
+		"":                          "",
+		"\x03www\x07exa":            "www",
+		"\x03www\xc0\x0c":           "www",
+	}
+
+	for qname, want := range tests {
+		if got := decodeDNSName(qname); got != want {
+			t.Errorf("decodeDNSName(%q) = %q, want %q", qname, got, want)
+		}
+	}
+}
+
+func TestNetworkDNSQueryFilters(t *testing.T) {
+	nfs := networkFilterSet{}
+	nfs.add(&api.NetworkEventFilter{
+		Type: api.NetworkEventType_NETWORK_EVENT_TYPE_DNS_QUERY,
+	})
+	nfs.add(&api.NetworkEventFilter{
+		Type: api.NetworkEventType_NETWORK_EVENT_TYPE_DNS_QUERY,
+		FilterExpression: expression.Equal(
+			expression.Identifier("sin_addr"),
+			expression.Value(uint32(0x0100007f))),
+	})
+
+	if len(nfs.dnsQueryFilters) != 2 {
+		t.Fatalf("Expected 2 DNS query filters, got %v", nfs.dnsQueryFilters)
+	}
+	if nfs.dnsQueryFilters[networkDNSPortFilter] != 1 {
+		t.Errorf("Expected the port filter alone for a wildcard filter, got %v",
+			nfs.dnsQueryFilters)
+	}
+	want := networkDNSPortFilter + " && (sin_addr == 16777343)"
+	if nfs.dnsQueryFilters[want] != 1 {
+		t.Errorf("Expected %q, got %v", want, nfs.dnsQueryFilters)
+	}
+}
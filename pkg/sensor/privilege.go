@@ -0,0 +1,321 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/golang/glog"
+)
+
+const (
+	privilegeExecTracepoint = "sched/sched_process_exec"
+
+	privilegeCapsetKprobeSymbol = "sys_capset"
+
+	// These offsets index into struct __user_cap_header_struct and the
+	// two-element array of struct __user_cap_data_struct passed to
+	// capset(2), which are part of the kernel's user ABI.
+	privilegeCapsetKprobeFetchargs = "version=+0(%di):u32 pid=+4(%di):s32 " +
+		"effective=+0(%si):u32 permitted=+4(%si):u32 inheritable=+8(%si):u32 " +
+		"effective_hi=+12(%si):u32 permitted_hi=+16(%si):u32 inheritable_hi=+20(%si):u32"
+
+	// _LINUX_CAPABILITY_VERSION_1 from include/uapi/linux/capability.h,
+	// the only version that passes a single 32-bit data struct
+	linuxCapabilityVersion1 = 0x19980330
+
+	// O_WRONLY | O_RDWR
+	openWriteFlags = 0x3
+)
+
+// privilegeSetIDKprobes lists the system calls that change a process's
+// user or group ids, with fetchargs naming each id argument after the id
+// it sets.
+var privilegeSetIDKprobes = []struct {
+	symbol    string
+	syscall   string
+	eventType api.PrivilegeEventType
+	fetchargs string
+}{
+	{"sys_setuid", "setuid", api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_SETUID,
+		"effective=%di:s32"},
+	{"sys_setreuid", "setreuid", api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_SETUID,
+		"real=%di:s32 effective=%si:s32"},
+	{"sys_setresuid", "setresuid", api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_SETUID,
+		"real=%di:s32 effective=%si:s32 saved=%dx:s32"},
+	{"sys_setfsuid", "setfsuid", api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_SETUID,
+		"fs=%di:s32"},
+	{"sys_setgid", "setgid", api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_SETGID,
+		"effective=%di:s32"},
+	{"sys_setregid", "setregid", api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_SETGID,
+		"real=%di:s32 effective=%si:s32"},
+	{"sys_setresgid", "setresgid", api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_SETGID,
+		"real=%di:s32 effective=%si:s32 saved=%dx:s32"},
+	{"sys_setfsgid", "setfsgid", api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_SETGID,
+		"fs=%di:s32"},
+}
+
+// privilegedPrograms are the programs whose execution is reported as a
+// PRIVILEGE_EVENT_TYPE_EXEC event, matched by basename.
+var privilegedPrograms = []string{
+	"sudo",
+	"su",
+	"pkexec",
+	"doas",
+	"runuser",
+	"sg",
+	"newgrp",
+}
+
+// accountFiles are the files whose modification is reported as a
+// PRIVILEGE_EVENT_TYPE_ACCOUNT_FILE_MODIFY event. Only absolute paths are
+// matched.
+var accountFiles = []string{
+	"/etc/passwd",
+	"/etc/shadow",
+	"/etc/group",
+	"/etc/gshadow",
+	"/etc/sudoers",
+}
+
+// namesFilterString returns a kernel filter matching any of the given
+// values of the named field. If glob is true, each value is matched as a
+// basename.
+func namesFilterString(field string, values []string, glob bool) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		if glob {
+			parts[i] = fmt.Sprintf("%s ~ \"*/%s\"", field, v)
+		} else {
+			parts[i] = fmt.Sprintf("%s == \"%s\"", field, v)
+		}
+	}
+	return strings.Join(parts, " || ")
+}
+
+type privilegeFilter struct {
+	sensor *Sensor
+}
+
+func setIDArg(data perf.TraceEventSampleData, name string) int64 {
+	if v, ok := data[name].(int32); ok {
+		return int64(v)
+	}
+	return -1
+}
+
+func (f *privilegeFilter) decodeSetID(eventType api.PrivilegeEventType, syscall string) perf.TraceEventDecoderFn {
+	return func(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+		ev := f.sensor.NewEventFromSample(sample, data)
+		ev.Event = &api.Event_Privilege{
+			Privilege: &api.PrivilegeEvent{
+				Type:        eventType,
+				Syscall:     syscall,
+				RealId:      setIDArg(data, "real"),
+				EffectiveId: setIDArg(data, "effective"),
+				SavedId:     setIDArg(data, "saved"),
+				FsId:        setIDArg(data, "fs"),
+			},
+		}
+
+		return ev, nil
+	}
+}
+
+func (f *privilegeFilter) decodeSysCapset(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	pev := &api.PrivilegeEvent{
+		Type:           api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_CAPSET,
+		CapPid:         data["pid"].(int32),
+		CapEffective:   uint64(data["effective"].(uint32)),
+		CapPermitted:   uint64(data["permitted"].(uint32)),
+		CapInheritable: uint64(data["inheritable"].(uint32)),
+	}
+	if data["version"].(uint32) != linuxCapabilityVersion1 {
+		pev.CapEffective |= uint64(data["effective_hi"].(uint32)) << 32
+		pev.CapPermitted |= uint64(data["permitted_hi"].(uint32)) << 32
+		pev.CapInheritable |= uint64(data["inheritable_hi"].(uint32)) << 32
+	}
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_Privilege{
+		Privilege: pev,
+	}
+
+	return ev, nil
+}
+
+func (f *privilegeFilter) decodeExec(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	hostPid := data["common_pid"].(int32)
+
+	commandLine, _ := f.sensor.processCache.ProcessCommandLine(int(hostPid))
+	if len(commandLine) == 0 {
+		commandLine = sys.HostProcFS().CommandLine(int(hostPid))
+	}
+
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_Privilege{
+		Privilege: &api.PrivilegeEvent{
+			Type:            api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_EXEC,
+			ExecFilename:    data["filename"].(string),
+			ExecCommandLine: commandLine,
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *privilegeFilter) decodeAccountFileOpen(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_Privilege{
+		Privilege: &api.PrivilegeEvent{
+			Type:      api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_ACCOUNT_FILE_MODIFY,
+			Filename:  data["filename"].(string),
+			OpenFlags: data["flags"].(int32),
+		},
+	}
+
+	return ev, nil
+}
+
+func (f *privilegeFilter) decodeAccountFileRename(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	ev := f.sensor.NewEventFromSample(sample, data)
+	ev.Event = &api.Event_Privilege{
+		Privilege: &api.PrivilegeEvent{
+			Type:              api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_ACCOUNT_FILE_MODIFY,
+			Filename:          data["newname"].(string),
+			RenameOldFilename: data["filename"].(string),
+		},
+	}
+
+	return ev, nil
+}
+
+type privilegeFilterSet struct {
+	filters map[api.PrivilegeEventType]map[string]int
+}
+
+func (pfs *privilegeFilterSet) add(pef *api.PrivilegeEventFilter) {
+	var filterString string
+
+	if pef.FilterExpression != nil {
+		expr, err := expression.NewExpression(pef.FilterExpression)
+		if err != nil {
+			glog.V(1).Infof("Bad privilege filter expression: %s", err)
+			return
+		}
+
+		err = expr.ValidateKernelFilter()
+		if err != nil {
+			glog.V(1).Infof("Bad privilege filter expression: %s", err)
+			return
+		}
+
+		filterString = expr.KernelFilterString()
+	}
+
+	if pfs.filters == nil {
+		pfs.filters = make(map[api.PrivilegeEventType]map[string]int)
+	}
+	if pfs.filters[pef.Type] == nil {
+		pfs.filters[pef.Type] = make(map[string]int)
+	}
+	pfs.filters[pef.Type][filterString]++
+}
+
+// filterString returns the kernel filter for the given event type, which
+// is the built-in filter for the type combined with the subscription's
+// filters, and whether any filter for the type was requested at all.
+func (pfs *privilegeFilterSet) filterString(eventType api.PrivilegeEventType, builtin string) (string, bool) {
+	return combinedFilterString(builtin, pfs.filters[eventType])
+}
+
+func registerPrivilegeKprobe(sensor *Sensor, eventMap subscriptionMap, symbol string, fetchargs string, fn perf.TraceEventDecoderFn, filter string) {
+	eventID, err := sensor.monitor.RegisterKprobe(symbol, false, fetchargs,
+		fn, perf.WithFilter(filter))
+	if err != nil {
+		glog.V(1).Infof("Couldn't register privilege kprobe %s: %v",
+			symbol, err)
+		return
+	}
+
+	eventMap[eventID] = &subscription{}
+}
+
+func registerPrivilegeEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.PrivilegeEventFilter) {
+	pfs := privilegeFilterSet{}
+	for _, pef := range events {
+		pfs.add(pef)
+	}
+
+	f := privilegeFilter{
+		sensor: sensor,
+	}
+
+	for _, k := range privilegeSetIDKprobes {
+		if s, ok := pfs.filterString(k.eventType, ""); ok {
+			registerPrivilegeKprobe(sensor, eventMap, k.symbol,
+				k.fetchargs, f.decodeSetID(k.eventType, k.syscall), s)
+		}
+	}
+
+	if s, ok := pfs.filterString(api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_CAPSET, ""); ok {
+		registerPrivilegeKprobe(sensor, eventMap, privilegeCapsetKprobeSymbol,
+			privilegeCapsetKprobeFetchargs, f.decodeSysCapset, s)
+	}
+
+	builtin := namesFilterString("filename", privilegedPrograms, true)
+	if s, ok := pfs.filterString(api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_EXEC, builtin); ok {
+		eventID, err := sensor.monitor.RegisterTracepoint(
+			privilegeExecTracepoint, f.decodeExec, perf.WithFilter(s))
+		if err != nil {
+			glog.V(1).Infof("Couldn't register %s: %v",
+				privilegeExecTracepoint, err)
+		} else {
+			eventMap[eventID] = &subscription{}
+		}
+	}
+
+	// Account files are modified either in place or, as by vipw(8) and
+	// useradd(8), by writing a new file and renaming it over the old one.
+	builtin = fmt.Sprintf("(%s) && flags & %d",
+		namesFilterString("filename", accountFiles, false), openWriteFlags)
+	if s, ok := pfs.filterString(api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_ACCOUNT_FILE_MODIFY, builtin); ok {
+		eventID, err := sensor.monitor.RegisterTracepoint("fs/do_sys_open",
+			f.decodeAccountFileOpen, perf.WithFilter(s))
+		if err != nil {
+			registerPrivilegeKprobe(sensor, eventMap,
+				fsDoSysOpenKprobeAddress, fsDoSysOpenKprobeFetchargs,
+				f.decodeAccountFileOpen, s)
+		} else {
+			eventMap[eventID] = &subscription{}
+		}
+	}
+
+	builtin = namesFilterString("newname", accountFiles, false)
+	if s, ok := pfs.filterString(api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_ACCOUNT_FILE_MODIFY, builtin); ok {
+		registerPrivilegeKprobe(sensor, eventMap, fsSysRenameKprobeAddress,
+			fsSysRenameKprobeFetchargs, f.decodeAccountFileRename, s)
+		registerPrivilegeKprobe(sensor, eventMap, fsSysRenameatKprobeAddress,
+			fsSysRenameatKprobeFetchargs, f.decodeAccountFileRename, s)
+		registerPrivilegeKprobe(sensor, eventMap, fsSysRenameat2KprobeAddress,
+			fsSysRenameatKprobeFetchargs, f.decodeAccountFileRename, s)
+	}
+}
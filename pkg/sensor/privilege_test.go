@@ -0,0 +1,78 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/capsule8/capsule8/pkg/sys/perf"
+)
+
+func TestNamesFilterString(t *testing.T) {
+	s := namesFilterString("filename", []string{"sudo", "su"}, true)
+	if want := `filename ~ "*/sudo" || filename ~ "*/su"`; s != want {
+		t.Errorf("Expected %s, got %s", want, s)
+	}
+
+	s = namesFilterString("newname", []string{"/etc/passwd"}, false)
+	if want := `newname == "/etc/passwd"`; s != want {
+		t.Errorf("Expected %s, got %s", want, s)
+	}
+}
+
+func TestPrivilegeFilterString(t *testing.T) {
+	setuid := api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_SETUID
+	exec := api.PrivilegeEventType_PRIVILEGE_EVENT_TYPE_EXEC
+
+	pfs := privilegeFilterSet{}
+	if _, ok := pfs.filterString(setuid, ""); ok {
+		t.Error("Expected no filter for unrequested event type")
+	}
+
+	pfs.add(&api.PrivilegeEventFilter{Type: exec})
+	if s, ok := pfs.filterString(exec, "builtin"); !ok || s != "builtin" {
+		t.Errorf("Expected builtin filter, got %q", s)
+	}
+
+	pfs.add(&api.PrivilegeEventFilter{
+		Type: setuid,
+		FilterExpression: expression.Equal(
+			expression.Identifier("effective"),
+			expression.Value(int32(0))),
+	})
+	s, ok := pfs.filterString(setuid, "")
+	if want := "(effective == 0)"; !ok || s != want {
+		t.Errorf("Expected %s, got %q", want, s)
+	}
+	s, ok = pfs.filterString(setuid, "builtin")
+	if want := "(builtin) && ((effective == 0))"; !ok || s != want {
+		t.Errorf("Expected %s, got %q", want, s)
+	}
+}
+
+func TestSetIDArg(t *testing.T) {
+	data := perf.TraceEventSampleData{
+		"effective": int32(0),
+	}
+	if v := setIDArg(data, "effective"); v != 0 {
+		t.Errorf("Expected 0, got %d", v)
+	}
+	if v := setIDArg(data, "real"); v != -1 {
+		t.Errorf("Expected -1 for missing argument, got %d", v)
+	}
+}
@@ -19,7 +19,7 @@ package sensor
 // system-global EventMonitor to keep it up-to-date. The cache also monitors
 // for runc container starts to identify the containerID for a given PID
 // namespace. Process information gathered by the cache may be retrieved via
-// the ProcessID and ProcessContainerID methods.
+// the ProcessID, ProcessContainerID, and ProcessLineage methods.
 //
 // glog levels used:
 //   10 = cache operation level tracing for debugging
@@ -30,6 +30,8 @@ import (
 	"strings"
 	"sync"
 
+	api "github.com/capsule8/capsule8/api/v0"
+
 	"github.com/capsule8/capsule8/pkg/config"
 	"github.com/capsule8/capsule8/pkg/sys"
 	"github.com/capsule8/capsule8/pkg/sys/perf"
@@ -302,6 +304,50 @@ func (pc *ProcessInfoCache) ProcessContainerID(pid int) (string, bool) {
 	return "", false
 }
 
+// ProcessLineage returns the chain of thread group leaders starting with the
+// process indicated by the given PID and following each process's parent, up
+// to maxDepth processes. The walk stops early at the first process that is
+// not in the cache.
+func (pc *ProcessInfoCache) ProcessLineage(pid int, maxDepth int) []*api.Process {
+	var lineage []*api.Process
+
+	for p := pid; len(lineage) < maxDepth; {
+		leader, ok := pc.lookupLeader(p)
+		if !ok {
+			break
+		}
+
+		lineage = append(lineage, &api.Process{
+			Pid:         int32(leader.pid),
+			Command:     leader.command,
+			ProcessId:   proc.DeriveUniqueID(leader.pid, leader.ppid),
+			CommandLine: leader.commandLine,
+			ContainerId: leader.containerID,
+		})
+
+		if leader.ppid == 0 || leader.ppid == leader.pid {
+			break
+		}
+		p = leader.ppid
+	}
+
+	// A process without a container ID of its own is in its parent's
+	// container, so only the oldest process needs a walk up the cache.
+	for i := len(lineage) - 1; i >= 0; i-- {
+		if len(lineage[i].ContainerId) > 0 {
+			continue
+		}
+		if i == len(lineage)-1 {
+			lineage[i].ContainerId, _ =
+				pc.ProcessContainerID(int(lineage[i].Pid))
+		} else {
+			lineage[i].ContainerId = lineage[i+1].ContainerId
+		}
+	}
+
+	return lineage
+}
+
 // ProcessCommandLine returns the command-line for a process. The command-line
 // is constructed from argv passed to execve(), but is limited to a fixed number
 // of elements of argv; therefore, it may not be complete.
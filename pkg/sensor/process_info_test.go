@@ -188,3 +188,48 @@ func BenchmarkContainerCacheMissParallel(b *testing.B) {
 		}
 	})
 }
+
+func TestProcessLineage(t *testing.T) {
+	pc := ProcessInfoCache{
+		cache: newMapTaskCache(),
+	}
+
+	// init (1) -> sshd (100) -> bash (200), plus a thread (201) in bash.
+	// sshd and its descendants are in container c1.
+	pc.cache.InsertTask(1, task{pid: 1, tgid: 1, ppid: 0, command: "init"})
+	pc.cache.InsertTask(100, task{pid: 100, tgid: 100, ppid: 1, command: "sshd",
+		containerID: "c1"})
+	pc.cache.InsertTask(200, task{pid: 200, tgid: 200, ppid: 100, command: "bash",
+		commandLine: []string{"/bin/bash", "-l"}})
+	pc.cache.InsertTask(201, task{pid: 201, tgid: 200, ppid: 200, command: "bash"})
+
+	lineage := pc.ProcessLineage(201, 8)
+	want := []string{"bash", "sshd", "init"}
+	if len(lineage) != len(want) {
+		t.Fatalf("Expected lineage of %d processes, got %d", len(want), len(lineage))
+	}
+	wantContainers := []string{"c1", "c1", ""}
+	for i, p := range lineage {
+		if p.Command != want[i] {
+			t.Errorf("Expected lineage[%d] to be %s, got %s", i, want[i], p.Command)
+		}
+		if p.ContainerId != wantContainers[i] {
+			t.Errorf("Expected lineage[%d] in container %q, got %q",
+				i, wantContainers[i], p.ContainerId)
+		}
+	}
+	if lineage[0].Pid != 200 || len(lineage[0].CommandLine) != 2 {
+		t.Errorf("Unexpected thread group leader in lineage: %+v", lineage[0])
+	}
+
+	lineage = pc.ProcessLineage(200, 2)
+	if len(lineage) != 2 {
+		t.Errorf("Expected lineage limited to 2 processes, got %d", len(lineage))
+	}
+
+	lineage = pc.ProcessLineage(200, 1)
+	if len(lineage) != 1 || lineage[0].ContainerId != "c1" {
+		t.Errorf("Expected container of truncated lineage to be c1, got %+v",
+			lineage)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+// redactor removes sensitive values from events before they are sent to
+// any subscription, according to the Sensor's Redact* configuration.
+type redactor struct {
+	arguments    []*regexp.Regexp
+	pathPrefixes []string
+	environment  bool
+	drop         bool
+	hashKey      []byte
+}
+
+// newRedactor returns a redactor for the given rules, or nil if there are
+// none.
+func newRedactor(arguments, pathPrefixes []string, environment bool, mode, hashKey string) (*redactor, error) {
+	r := &redactor{
+		environment: environment,
+		hashKey:     []byte(hashKey),
+	}
+
+	switch mode {
+	case "hash":
+	case "drop":
+		r.drop = true
+	default:
+		return nil, fmt.Errorf("Invalid redaction mode %q", mode)
+	}
+
+	for _, a := range arguments {
+		re, err := regexp.Compile(a)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid argument redaction pattern %q: %s", a, err)
+		}
+		r.arguments = append(r.arguments, re)
+	}
+
+	for _, p := range pathPrefixes {
+		if !filepath.IsAbs(p) {
+			return nil, fmt.Errorf("Redacted path prefix %q is not absolute", p)
+		}
+		r.pathPrefixes = append(r.pathPrefixes, filepath.Clean(p))
+	}
+
+	if len(r.arguments) == 0 && len(r.pathPrefixes) == 0 && !r.environment {
+		return nil, nil
+	}
+	return r, nil
+}
+
+func (r *redactor) hash(value string) string {
+	mac := hmac.New(sha256.New, r.hashKey)
+	mac.Write([]byte(value))
+	return "sha256:" + hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+// redactCommandLine returns the command line with matching arguments
+// redacted. The command line given is not modified, since it may be
+// shared with the process info cache.
+func (r *redactor) redactCommandLine(commandLine []string) []string {
+	if len(r.arguments) == 0 {
+		return commandLine
+	}
+
+	var redacted []string
+	for i, arg := range commandLine {
+		value, keep := arg, true
+		for _, re := range r.arguments {
+			m := re.FindStringSubmatchIndex(value)
+			if m == nil {
+				continue
+			}
+			if len(m) < 4 || m[2] < 0 {
+				// No subexpression; the whole argument
+				if r.drop {
+					keep = false
+				} else {
+					value = r.hash(value)
+				}
+				break
+			}
+			replacement := ""
+			if !r.drop {
+				replacement = r.hash(value[m[2]:m[3]])
+			}
+			value = value[:m[2]] + replacement + value[m[3]:]
+		}
+
+		if redacted == nil && (!keep || value != arg) {
+			redacted = make([]string, i, len(commandLine))
+			copy(redacted, commandLine[:i])
+		}
+		if redacted != nil && keep {
+			redacted = append(redacted, value)
+		}
+	}
+
+	if redacted == nil {
+		return commandLine
+	}
+	return redacted
+}
+
+func (r *redactor) redactPath(path string) string {
+	for _, prefix := range r.pathPrefixes {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") ||
+			prefix == "/" && strings.HasPrefix(path, "/") {
+			if r.drop {
+				return ""
+			}
+			return r.hash(path)
+		}
+	}
+	return path
+}
+
+// redactEnvironmentList redacts the value of each NAME=value element of
+// a container configuration's environment.
+func (r *redactor) redactEnvironmentList(env interface{}) interface{} {
+	list, ok := env.([]interface{})
+	if !ok {
+		return env
+	}
+
+	redacted := make([]interface{}, 0, len(list))
+	for _, v := range list {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if r.drop {
+			continue
+		}
+		if i := strings.IndexByte(s, '='); i >= 0 {
+			s = s[:i+1] + r.hash(s[i+1:])
+		} else {
+			s = r.hash(s)
+		}
+		redacted = append(redacted, s)
+	}
+	return redacted
+}
+
+// redactConfigJSON redacts the environment in the JSON-encoded container
+// configuration at the path of object keys given. A configuration that
+// can't be decoded is dropped entirely.
+func (r *redactor) redactConfigJSON(config string, keys ...string) string {
+	if !r.environment || len(config) == 0 {
+		return config
+	}
+
+	var root map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &root); err != nil {
+		return ""
+	}
+
+	m := root
+	for _, k := range keys[:len(keys)-1] {
+		if m, _ = m[k].(map[string]interface{}); m == nil {
+			return config
+		}
+	}
+	env, ok := m[keys[len(keys)-1]]
+	if !ok {
+		return config
+	}
+	m[keys[len(keys)-1]] = r.redactEnvironmentList(env)
+
+	b, err := json.Marshal(root)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// redactProcessStates redacts the command lines of the processes in place.
+// The states must not be shared.
+func (r *redactor) redactProcessStates(processes []*api.ProcessState) {
+	if r == nil || len(r.arguments) == 0 {
+		return
+	}
+
+	for _, ps := range processes {
+		if ps.Process != nil {
+			ps.Process.CommandLine = r.redactCommandLine(ps.Process.CommandLine)
+		}
+	}
+}
+
+// redact redacts the event in place. Nested messages that may be shared
+// with other events are replaced rather than modified.
+func (r *redactor) redact(e *api.Event) {
+	if r == nil {
+		return
+	}
+
+	if len(e.ProcessLineage) > 0 && len(r.arguments) > 0 {
+		lineage := make([]*api.Process, len(e.ProcessLineage))
+		for i, p := range e.ProcessLineage {
+			rp := *p
+			rp.CommandLine = r.redactCommandLine(p.CommandLine)
+			lineage[i] = &rp
+		}
+		e.ProcessLineage = lineage
+	}
+
+	switch ev := e.Event.(type) {
+	case *api.Event_Process:
+		p := *ev.Process
+		p.ExecFilename = r.redactPath(p.ExecFilename)
+		p.ExecCommandLine = r.redactCommandLine(p.ExecCommandLine)
+		e.Event = &api.Event_Process{Process: &p}
+
+	case *api.Event_File:
+		f := *ev.File
+		f.Filename = r.redactPath(f.Filename)
+		f.RenameNewFilename = r.redactPath(f.RenameNewFilename)
+		e.Event = &api.Event_File{File: &f}
+
+	case *api.Event_Privilege:
+		p := *ev.Privilege
+		p.ExecFilename = r.redactPath(p.ExecFilename)
+		p.ExecCommandLine = r.redactCommandLine(p.ExecCommandLine)
+		p.Filename = r.redactPath(p.Filename)
+		p.RenameOldFilename = r.redactPath(p.RenameOldFilename)
+		e.Event = &api.Event_Privilege{Privilege: &p}
+
+	case *api.Event_Container:
+		c := *ev.Container
+		c.DockerConfigJson = r.redactConfigJSON(c.DockerConfigJson,
+			"Config", "Env")
+		c.OciConfigJson = r.redactConfigJSON(c.OciConfigJson,
+			"process", "env")
+		e.Event = &api.Event_Container{Container: &c}
+	}
+}
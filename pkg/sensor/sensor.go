@@ -20,9 +20,12 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	api "github.com/capsule8/capsule8/api/v0"
@@ -32,7 +35,9 @@ import (
 	"github.com/capsule8/capsule8/pkg/stream"
 	"github.com/capsule8/capsule8/pkg/sys"
 	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/capsule8/capsule8/pkg/validate"
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
 
 	"golang.org/x/sys/unix"
 )
@@ -57,6 +62,12 @@ type Sensor struct {
 	// Metrics counters for this sensor
 	Metrics MetricsCounters
 
+	// Monitor of the sensor's own resource usage
+	selfMonitor *selfMonitor
+
+	// Counts of events lost from the perf ring buffers
+	lostEvents lostEventCounts
+
 	// Repeater used for container event subscriptions
 	containerEventRepeater *containerEventRepeater
 
@@ -68,6 +79,9 @@ type Sensor struct {
 	// caching process information
 	monitor *perf.EventMonitor
 
+	// Non-zero while the event monitor is running
+	monitorRunning int32
+
 	// Per-sensor process cache.
 	processCache ProcessInfoCache
 
@@ -78,6 +92,17 @@ type Sensor struct {
 	// argument filters
 	dummySyscallEventID    uint64
 	dummySyscallEventCount int64
+
+	// The most recent events sent to subscriptions, for the state API
+	recentEvents *eventRing
+
+	// Redaction rules applied to events before they reach subscriptions,
+	// or nil if there are none
+	redactor *redactor
+
+	// Closed by StopSubscriptions to end every subscription
+	stopping chan struct{}
+	stopOnce sync.Once
 }
 
 // NewSensor creates a new Sensor instance.
@@ -94,7 +119,18 @@ func NewSensor() (*Sensor, error) {
 		ID:                sensorID,
 		bootMonotimeNanos: bootMonotimeNanos,
 		eventMap:          newSafeSubscriptionMap(),
+		recentEvents:      newEventRing(config.Sensor.RecentEventsBufferSize),
+		stopping:          make(chan struct{}),
 	}
+	s.selfMonitor = newSelfMonitor(s, config.Sensor.SelfMonitorInterval)
+
+	r, err := newRedactor(config.Sensor.RedactArguments,
+		config.Sensor.RedactPathPrefixes, config.Sensor.RedactEnvironment,
+		config.Sensor.RedactMode, config.Sensor.RedactHashKey)
+	if err != nil {
+		return nil, err
+	}
+	s.redactor = r
 
 	cer, err := newContainerEventRepeater(s)
 	if err != nil {
@@ -157,12 +193,30 @@ func (s *Sensor) Start() error {
 	// are active
 	s.monitor.EnableAll()
 
+	s.selfMonitor.start()
+
 	return nil
 }
 
-// Stop stops a running sensor instance.
+// StopSubscriptions ends every subscription, current and future, as the
+// first step of shutting the sensor down. Each subscription's stream is
+// ended after any events held by its modifiers have been passed on, and
+// its final status reports that the sensor is stopping.
+func (s *Sensor) StopSubscriptions() {
+	s.stopOnce.Do(func() {
+		glog.V(1).Info("Ending all subscriptions")
+		close(s.stopping)
+	})
+}
+
+// Stop stops a running sensor instance. Any subscriptions are ended, and
+// all kernel events, including kprobes, are removed.
 func (s *Sensor) Stop() {
+	s.StopSubscriptions()
+
 	if s.monitor != nil {
+		s.selfMonitor.stop()
+
 		glog.V(2).Info("Stopping sensor-global EventMonitor")
 		s.monitor.Close(true)
 		s.monitor = nil
@@ -183,13 +237,58 @@ func (s *Sensor) dispatchSample(eventID uint64, sample interface{}, err error) {
 		glog.Warning(err)
 	}
 
-	if event, ok := sample.(*api.Event); ok && event != nil {
-		eventMap := s.eventMap.getMap()
-		if sub, ok := eventMap[eventID]; ok && sub != nil {
-			if sub.data != nil {
-				sub.data <- event
+	switch sample := sample.(type) {
+	case *api.Event:
+		if sample != nil {
+			eventMap := s.eventMap.getMap()
+			if sub, ok := eventMap[eventID]; ok && sub != nil {
+				if sub.data != nil {
+					s.redactor.redact(sample)
+					sub.data <- sample
+				}
 			}
 		}
+
+	case *perf.Sample:
+		if lr, ok := sample.Record.(*perf.LostRecord); ok {
+			s.dispatchLostRecord(eventID, sample, lr)
+		}
+	}
+}
+
+// dispatchLostRecord accounts for events lost from a perf ring buffer and
+// notifies every subscription receiving kernel events. The ring buffers are
+// shared, so any of them may be missing events.
+func (s *Sensor) dispatchLostRecord(eventID uint64, sample *perf.Sample, lr *perf.LostRecord) {
+	eventMap := s.eventMap.getMap()
+
+	// Events registered by the sensor for its own use (i.e. for the
+	// process info cache) aren't in the subscription map.
+	source := "sensor"
+	if sub, ok := eventMap[eventID]; ok && len(sub.source) > 0 {
+		source = sub.source
+	}
+
+	cpu := int32(sample.CPU)
+	glog.V(1).Infof("Lost %d events on CPU %d (%s)", lr.Lost, cpu, source)
+	s.lostEvents.add(source, cpu, lr.Lost)
+
+	notified := make(map[chan interface{}]bool)
+	for _, sub := range eventMap {
+		if sub == nil || sub.data == nil || notified[sub.data] {
+			continue
+		}
+		notified[sub.data] = true
+
+		e := s.NewEvent()
+		e.Cpu = cpu
+		e.Event = &api.Event_LostEvents{
+			LostEvents: &api.LostEventsEvent{
+				Count:  lr.Lost,
+				Source: source,
+			},
+		}
+		sub.data <- e
 	}
 }
 
@@ -261,13 +360,14 @@ func (s *Sensor) NewEvent() *api.Event {
 	h := sha256.Sum256(buf.Bytes())
 	eventID := hex.EncodeToString(h[:])
 
-	s.Metrics.Events++
+	atomic.AddUint64(&s.Metrics.Events, 1)
 
 	return &api.Event{
 		Id:                   eventID,
 		SensorId:             s.ID,
 		SensorMonotimeNanos:  monotime,
 		SensorSequenceNumber: sequenceNumber,
+		SchemaVersion:        api.SchemaVersion,
 	}
 }
 
@@ -276,9 +376,38 @@ func (s *Sensor) NewEvent() *api.Event {
 func (s *Sensor) NewEventFromContainer(containerID string) *api.Event {
 	e := s.NewEvent()
 	e.ContainerId = containerID
+	e.Kubernetes = kubernetesMetadata(containerID)
 	return e
 }
 
+// kubernetesMetadata returns the Kubernetes metadata to attach to events
+// associated with the given container, if the Sensor is configured to
+// report it and the container belongs to a pod.
+func kubernetesMetadata(containerID string) *api.KubernetesMetadata {
+	if !config.Sensor.KubernetesMetadata {
+		return nil
+	}
+
+	k := container.GetKubernetesInfo(containerID)
+	if k == nil {
+		return nil
+	}
+
+	m := &api.KubernetesMetadata{
+		PodName:       k.PodName,
+		PodNamespace:  k.PodNamespace,
+		PodUid:        k.PodUID,
+		ContainerName: k.ContainerName,
+	}
+	if len(k.PodLabels) > 0 {
+		m.PodLabels = make(map[string]string, len(k.PodLabels))
+		for name, value := range k.PodLabels {
+			m.PodLabels[name] = value
+		}
+	}
+	return m
+}
+
 // NewEventFromSample creates a new API Event instance using perf_event sample
 // information.
 func (s *Sensor) NewEventFromSample(sample *perf.SampleRecord,
@@ -298,6 +427,11 @@ func (s *Sensor) NewEventFromSample(sample *perf.SampleRecord,
 		e.ProcessId = processID
 	}
 
+	if config.Sensor.ProcessLineageDepth > 0 {
+		e.ProcessLineage = s.processCache.ProcessLineage(
+			int(e.ProcessPid), config.Sensor.ProcessLineageDepth)
+	}
+
 	// Add an associated containerId
 	containerID, ok := s.processCache.ProcessContainerID(int(e.ProcessPid))
 	if ok {
@@ -311,6 +445,7 @@ func (s *Sensor) NewEventFromSample(sample *perf.SampleRecord,
 			e.ImageId = containerInfo.ImageID
 			e.ImageName = containerInfo.ImageName
 		}
+		e.Kubernetes = kubernetesMetadata(containerID)
 	}
 
 	return e
@@ -400,8 +535,10 @@ func (s *Sensor) createEventMonitor() error {
 		}
 	}
 
+	atomic.StoreInt32(&s.monitorRunning, 1)
 	go func() {
 		err := s.monitor.Run(s.dispatchSample)
+		atomic.StoreInt32(&s.monitorRunning, 0)
 		if err != nil {
 			glog.Fatal(err)
 		}
@@ -411,14 +548,64 @@ func (s *Sensor) createEventMonitor() error {
 	return nil
 }
 
-func (s *Sensor) createPerfEventStream(sub *api.Subscription) (*stream.Stream, error) {
+func (s *Sensor) createPerfEventStream(sub *api.Subscription, status *subscriptionStatus) (*stream.Stream, error) {
 	eventMap := newSubscriptionMap()
+	ef := sub.EventFilter
+
+	sources := []struct {
+		name      string
+		requested int
+		register  func()
+	}{
+		{"file", len(ef.FileEvents), func() {
+			registerFileEvents(s, eventMap, ef.FileEvents)
+		}},
+		{"kernel", len(ef.KernelEvents), func() {
+			registerKernelEvents(s, eventMap, ef.KernelEvents)
+		}},
+		{"kernel_load", len(ef.KernelLoadEvents), func() {
+			registerKernelLoadEvents(s, eventMap, ef.KernelLoadEvents)
+		}},
+		{"memory", len(ef.MemoryEvents), func() {
+			registerMemoryEvents(s, eventMap, ef.MemoryEvents)
+		}},
+		{"network", len(ef.NetworkEvents), func() {
+			registerNetworkEvents(s, eventMap, ef.NetworkEvents)
+		}},
+		{"privilege", len(ef.PrivilegeEvents), func() {
+			registerPrivilegeEvents(s, eventMap, ef.PrivilegeEvents)
+		}},
+		{"process", len(ef.ProcessEvents), func() {
+			registerProcessEvents(s, eventMap, ef.ProcessEvents)
+		}},
+		{"syscall", len(ef.SyscallEvents), func() {
+			registerSyscallEvents(s, eventMap, ef.SyscallEvents)
+		}},
+	}
+	for _, src := range sources {
+		if src.requested == 0 {
+			continue
+		}
+
+		// Registration failures are logged by the register
+		// functions. All that can be reported here is whether
+		// anything at all could be attached for the source.
+		n := len(eventMap)
+		src.register()
+		n = len(eventMap) - n
+
+		for _, eventSub := range eventMap {
+			if len(eventSub.source) == 0 {
+				eventSub.source = src.name
+			}
+		}
 
-	registerFileEvents(s, eventMap, sub.EventFilter.FileEvents)
-	registerKernelEvents(s, eventMap, sub.EventFilter.KernelEvents)
-	registerNetworkEvents(s, eventMap, sub.EventFilter.NetworkEvents)
-	registerProcessEvents(s, eventMap, sub.EventFilter.ProcessEvents)
-	registerSyscallEvents(s, eventMap, sub.EventFilter.SyscallEvents)
+		var err string
+		if n == 0 {
+			err = "No kernel events could be attached"
+		}
+		status.addSource(src.name, n, err)
+	}
 
 	if len(eventMap) == 0 {
 		return nil, nil
@@ -464,9 +651,56 @@ func (s *Sensor) createPerfEventStream(sub *api.Subscription) (*stream.Stream, e
 	}, nil
 }
 
+// eventKeyFunc returns a stream.KeyFunc for the given ThrottleModifier key.
+func eventKeyFunc(key string) stream.KeyFunc {
+	f := validate.EventKeys[key]
+	return func(e interface{}) string {
+		return f(e.(*api.Event))
+	}
+}
+
+// coalesceKey returns a key that is the same for events that differ only
+// in their id, sequence number, timestamp and CPU, since a thread may
+// migrate between CPUs. Kubernetes metadata is left out since it is
+// determined by the container id.
+func coalesceKey(e interface{}) string {
+	key := *e.(*api.Event)
+	key.Id = ""
+	key.SensorSequenceNumber = 0
+	key.SensorMonotimeNanos = 0
+	key.Cpu = 0
+	key.Kubernetes = nil
+
+	// The text format is used because, unlike the binary encoding, it
+	// orders map entries.
+	return proto.CompactTextString(&key)
+}
+
+func coalesceEvents(first, last interface{}, count uint64) interface{} {
+	merged := *first.(*api.Event)
+	merged.CoalescedCount = count
+	merged.LastSensorMonotimeNanos = last.(*api.Event).SensorMonotimeNanos
+	return &merged
+}
+
 func (s *Sensor) applyModifiers(eventStream *stream.Stream, modifier api.Modifier) *stream.Stream {
+	if modifier.Coalesce != nil {
+		eventStream = stream.Coalesce(eventStream, *modifier.Coalesce,
+			coalesceKey, coalesceEvents)
+	}
+
+	if modifier.Sample != nil {
+		eventStream = stream.Sample(eventStream, *modifier.Sample)
+	}
+
 	if modifier.Throttle != nil {
-		eventStream = stream.Throttle(eventStream, *modifier.Throttle)
+		if len(modifier.Throttle.Key) > 0 {
+			eventStream = stream.ThrottleByKey(eventStream,
+				*modifier.Throttle,
+				eventKeyFunc(modifier.Throttle.Key))
+		} else {
+			eventStream = stream.Throttle(eventStream, *modifier.Throttle)
+		}
 	}
 
 	if modifier.Limit != nil {
@@ -476,26 +710,71 @@ func (s *Sensor) applyModifiers(eventStream *stream.Stream, modifier api.Modifie
 	return eventStream
 }
 
+// ValidateSubscription checks that all of the filter expressions in the given
+// api.Subscription descriptor are well-formed and, for events that are
+// filtered in the kernel, can be used as kernel filters. Modifier parameters
+// are also checked. The first problem found is returned as an error.
+func ValidateSubscription(sub *api.Subscription) error {
+	return validate.Subscription(sub)
+}
+
+// InvalidSubscriptionError is returned by NewSubscription when the given
+// api.Subscription descriptor fails ValidateSubscription.
+type InvalidSubscriptionError struct {
+	Err error
+}
+
+func (e *InvalidSubscriptionError) Error() string {
+	return e.Err.Error()
+}
+
 // NewSubscription creates a new telemetry subscription from the given
 // api.Subscription descriptor. NewSubscription returns a stream.Stream of
 // api.Events matching the specified filters. Closing the Stream cancels the
-// subscription.
+// subscription. If the subscription specifies a ForDuration, the Stream's
+// data channel is closed once that many nanoseconds have elapsed, after
+// which the subscriber is expected to close the Stream. An invalid
+// subscription is reported as an *InvalidSubscriptionError.
 func (s *Sensor) NewSubscription(sub *api.Subscription) (*stream.Stream, error) {
+	eventStream, _, err := s.newSubscription(sub)
+	return eventStream, err
+}
+
+// newSubscription is the same as NewSubscription, but also returns the
+// status of the new subscription.
+func (s *Sensor) newSubscription(sub *api.Subscription) (*stream.Stream, *subscriptionStatus, error) {
 	glog.V(1).Infof("Subscribing to %+v", sub)
 
+	if err := ValidateSubscription(sub); err != nil {
+		return nil, nil, &InvalidSubscriptionError{Err: err}
+	}
+
+	select {
+	case <-s.stopping:
+		return nil, nil, errors.New("Sensor is stopping")
+	default:
+	}
+
+	status := &subscriptionStatus{
+		sensorStopping: s.stopping,
+	}
+
 	eventStream, joiner := stream.NewJoiner()
 	joiner.Off()
 
 	if len(sub.EventFilter.FileEvents) > 0 ||
 		len(sub.EventFilter.KernelEvents) > 0 ||
+		len(sub.EventFilter.KernelLoadEvents) > 0 ||
+		len(sub.EventFilter.MemoryEvents) > 0 ||
 		len(sub.EventFilter.NetworkEvents) > 0 ||
+		len(sub.EventFilter.PrivilegeEvents) > 0 ||
 		len(sub.EventFilter.ProcessEvents) > 0 ||
 		len(sub.EventFilter.SyscallEvents) > 0 {
 
-		pes, err := s.createPerfEventStream(sub)
+		pes, err := s.createPerfEventStream(sub, status)
 		if err != nil {
 			joiner.Close()
-			return nil, err
+			return nil, nil, err
 		}
 		if pes != nil {
 			joiner.Add(pes)
@@ -506,29 +785,56 @@ func (s *Sensor) NewSubscription(sub *api.Subscription) (*stream.Stream, error)
 		ces, err := s.containerEventRepeater.newEventStream(sub)
 		if err != nil {
 			joiner.Close()
-			return nil, err
+			return nil, nil, err
 		}
 		joiner.Add(ces)
+		status.addSource("container", 0, "")
+	}
+
+	if len(sub.EventFilter.AlertEvents) > 0 {
+		as, err := newAlertSource(s, sub.EventFilter.AlertEvents)
+		if err != nil {
+			joiner.Close()
+			return nil, nil, err
+		}
+		joiner.Add(as)
+		status.addSource("alert", 0, "")
 	}
 
 	for _, cf := range sub.EventFilter.ChargenEvents {
 		cs, err := newChargenSource(s, cf)
 		if err != nil {
 			joiner.Close()
-			return nil, err
+			return nil, nil, err
 		}
 		joiner.Add(cs)
+		status.addSource("chargen", 0, "")
 	}
 
 	for _, tf := range sub.EventFilter.TickerEvents {
 		ts, err := newTickerSource(s, tf)
 		if err != nil {
 			joiner.Close()
-			return nil, err
+			return nil, nil, err
 		}
 		joiner.Add(ts)
+		status.addSource("ticker", 0, "")
 	}
 
+	for _, mf := range sub.EventFilter.MetricsEvents {
+		ms, err := newMetricsSource(s, mf)
+		if err != nil {
+			joiner.Close()
+			return nil, nil, err
+		}
+		joiner.Add(ms)
+		status.addSource("metrics", 0, "")
+	}
+
+	// End the subscription when the sensor stops. This is done ahead of
+	// the modifiers so that they can flush what they are holding.
+	eventStream = stream.UntilDone(eventStream, s.stopping)
+
 	if sub.ContainerFilter != nil {
 		// Filter stream as requested by subscriber in the
 		// specified ContainerFilter to restrict the events to
@@ -539,14 +845,30 @@ func (s *Sensor) NewSubscription(sub *api.Subscription) (*stream.Stream, error)
 		eventStream = stream.Do(eventStream, cef.DoFunc)
 	}
 
+	eventStream = stream.Do(eventStream, func(e interface{}) {
+		atomic.AddUint64(&status.matched, 1)
+	})
+
 	if sub.Modifier != nil {
 		eventStream = s.applyModifiers(eventStream, *sub.Modifier)
 	}
 
-	s.Metrics.Subscriptions++
+	if sub.ForDuration != nil {
+		eventStream = stream.Until(eventStream,
+			time.Duration(sub.ForDuration.Value))
+	}
+
+	eventStream = stream.Do(eventStream, func(e interface{}) {
+		atomic.AddUint64(&status.sent, 1)
+		if ev, ok := e.(*api.Event); ok {
+			s.recentEvents.add(ev)
+		}
+	})
+
+	atomic.AddInt32(&s.Metrics.Subscriptions, 1)
 	joiner.On()
 
-	return eventStream, nil
+	return eventStream, status, nil
 }
 
 func filterNils(e interface{}) bool {
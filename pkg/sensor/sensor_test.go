@@ -0,0 +1,142 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/capsule8/capsule8/pkg/expression"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+func TestValidateSubscription(t *testing.T) {
+	good := &api.Subscription{
+		EventFilter: &api.EventFilter{
+			FileEvents: []*api.FileEventFilter{
+				&api.FileEventFilter{
+					Type: api.FileEventType_FILE_EVENT_TYPE_OPEN,
+					FilterExpression: expression.Like(
+						expression.Identifier("filename"),
+						expression.Value("/tmp/*")),
+				},
+			},
+		},
+	}
+	if err := ValidateSubscription(good); err != nil {
+		t.Errorf("Unexpected error for valid subscription: %s", err)
+	}
+
+	bad := &api.Subscription{
+		EventFilter: &api.EventFilter{
+			FileEvents: []*api.FileEventFilter{
+				&api.FileEventFilter{
+					Type: api.FileEventType_FILE_EVENT_TYPE_OPEN,
+					FilterExpression: expression.IsNull(
+						expression.Identifier("filename")),
+				},
+			},
+		},
+	}
+	if err := ValidateSubscription(bad); err == nil {
+		t.Error("Expected error for filter that can't be a kernel filter")
+	}
+
+	if err := ValidateSubscription(&api.Subscription{}); err == nil {
+		t.Error("Expected error for subscription with no event filter")
+	}
+
+	good.Modifier = &api.Modifier{
+		Coalesce: &api.CoalesceModifier{},
+	}
+	if err := ValidateSubscription(good); err == nil {
+		t.Error("Expected error for non-positive coalesce window")
+	}
+	good.Modifier = nil
+
+	good.ForDuration = &wrappers.Int64Value{Value: 0}
+	if err := ValidateSubscription(good); err == nil {
+		t.Error("Expected error for non-positive subscription duration")
+	}
+}
+
+func TestCoalesceEvents(t *testing.T) {
+	newEvent := func(id string, monotime int64, filename string) *api.Event {
+		return &api.Event{
+			Id:                   id,
+			ProcessId:            "p1",
+			SensorSequenceNumber: uint64(monotime),
+			SensorMonotimeNanos:  monotime,
+			Event: &api.Event_File{
+				File: &api.FileEvent{
+					Type:     api.FileEventType_FILE_EVENT_TYPE_OPEN,
+					Filename: filename,
+				},
+			},
+		}
+	}
+
+	first := newEvent("a", 1, "/etc/passwd")
+	last := newEvent("b", 2, "/etc/passwd")
+	other := newEvent("c", 3, "/etc/shadow")
+
+	if coalesceKey(first) != coalesceKey(last) {
+		t.Error("Expected identical events to have the same coalesce key")
+	}
+	migrated := newEvent("d", 4, "/etc/passwd")
+	migrated.Cpu = 3
+	if coalesceKey(first) != coalesceKey(migrated) {
+		t.Error("Expected identical events on different CPUs to have the same coalesce key")
+	}
+	if coalesceKey(first) == coalesceKey(other) {
+		t.Error("Expected events for different files to have different coalesce keys")
+	}
+
+	merged := coalesceEvents(first, last, 2).(*api.Event)
+	if merged.Id != "a" || merged.SensorMonotimeNanos != 1 ||
+		merged.CoalescedCount != 2 || merged.LastSensorMonotimeNanos != 2 {
+		t.Errorf("Unexpected merged event %+v", merged)
+	}
+	if first.CoalescedCount != 0 || first.LastSensorMonotimeNanos != 0 {
+		t.Errorf("Merging modified the first event: %+v", first)
+	}
+}
+
+func BenchmarkNewEvent(b *testing.B) {
+	s := &Sensor{ID: "benchmark"}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = s.NewEvent()
+	}
+}
+
+func BenchmarkCoalesceKey(b *testing.B) {
+	e := &api.Event{
+		Id:        "a",
+		ProcessId: "p1",
+		Event: &api.Event_File{
+			File: &api.FileEvent{
+				Type:     api.FileEventType_FILE_EVENT_TYPE_OPEN,
+				Filename: "/etc/passwd",
+			},
+		},
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = coalesceKey(e)
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"sort"
+	"sync"
+
+	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/capsule8/capsule8/pkg/container"
+	"github.com/capsule8/capsule8/pkg/sys"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// eventRing keeps the most recent events sent to telemetry subscriptions.
+// An event matched by more than one subscription is recorded once for
+// each of them.
+type eventRing struct {
+	sync.Mutex
+	events []*api.Event
+	next   int
+	full   bool
+}
+
+func newEventRing(size int) *eventRing {
+	if size <= 0 {
+		return nil
+	}
+	return &eventRing{
+		events: make([]*api.Event, size),
+	}
+}
+
+func (r *eventRing) add(e *api.Event) {
+	if r == nil {
+		return
+	}
+
+	r.Lock()
+	r.events[r.next] = e
+	r.next++
+	if r.next == len(r.events) {
+		r.next = 0
+		r.full = true
+	}
+	r.Unlock()
+}
+
+// snapshot returns up to limit of the buffered events, oldest first,
+// optionally only those associated with the given container. A limit of 0
+// returns all of them.
+func (r *eventRing) snapshot(containerID string, limit int) []*api.Event {
+	if r == nil {
+		return nil
+	}
+
+	r.Lock()
+	var ordered []*api.Event
+	if r.full {
+		ordered = append(ordered, r.events[r.next:]...)
+	}
+	ordered = append(ordered, r.events[:r.next]...)
+	r.Unlock()
+
+	var events []*api.Event
+	for _, e := range ordered {
+		if len(containerID) == 0 || e.ContainerId == containerID {
+			events = append(events, e)
+		}
+	}
+	if limit > 0 && len(events) > limit {
+		events = events[len(events)-limit:]
+	}
+	return events
+}
+
+type stateServiceServer struct {
+	sensor *Sensor
+}
+
+// processStates returns the state of every process currently visible in
+// the host procfs.
+func (s *stateServiceServer) processStates() ([]*api.ProcessState, error) {
+	procFS := sys.HostProcFS()
+	pids, err := procFS.Pids()
+	if err != nil {
+		return nil, err
+	}
+	sort.Ints(pids)
+
+	processes := make([]*api.ProcessState, 0, len(pids))
+	for _, pid := range pids {
+		stat := procFS.Stat(pid)
+		if stat == nil {
+			// The process exited while the walk was underway
+			continue
+		}
+
+		containerID, ok := s.sensor.processCache.ProcessContainerID(pid)
+		if !ok {
+			containerID, _ = procFS.ContainerID(pid)
+		}
+
+		ppid := stat.ParentPID()
+		ps := &api.ProcessState{
+			Process: &api.Process{
+				Pid:         int32(pid),
+				Command:     stat.Command(),
+				ProcessId:   stat.UniqueID(),
+				CommandLine: procFS.CommandLine(pid),
+				ContainerId: containerID,
+			},
+			ParentPid: int32(ppid),
+		}
+		if ppid != 0 {
+			ps.ParentProcessId = procFS.UniqueID(ppid)
+		}
+		processes = append(processes, ps)
+	}
+
+	// Command lines are read from procfs rather than from events, so
+	// they must be redacted here too.
+	s.sensor.redactor.redactProcessStates(processes)
+
+	return processes, nil
+}
+
+func (s *stateServiceServer) GetProcesses(ctx context.Context, req *api.GetProcessesRequest) (*api.GetProcessesResponse, error) {
+	processes, err := s.processStates()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if len(req.ContainerId) > 0 {
+		var filtered []*api.ProcessState
+		for _, ps := range processes {
+			if ps.Process.ContainerId == req.ContainerId {
+				filtered = append(filtered, ps)
+			}
+		}
+		processes = filtered
+	}
+
+	return &api.GetProcessesResponse{
+		Processes: processes,
+	}, nil
+}
+
+func (s *stateServiceServer) GetContainers(ctx context.Context, req *api.GetContainersRequest) (*api.GetContainersResponse, error) {
+	processes, err := s.processStates()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	pids := make(map[string][]int32)
+	for _, ps := range processes {
+		if cID := ps.Process.ContainerId; len(cID) > 0 {
+			pids[cID] = append(pids[cID], ps.Process.Pid)
+		}
+	}
+
+	infos := container.List()
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+
+	containers := make([]*api.ContainerState, len(infos))
+	for i, info := range infos {
+		containers[i] = &api.ContainerState{
+			ContainerId:   info.ID,
+			ContainerName: info.Name,
+			ImageId:       info.ImageID,
+			ImageName:     info.ImageName,
+			Kubernetes:    kubernetesMetadata(info.ID),
+			Pids:          pids[info.ID],
+		}
+	}
+
+	return &api.GetContainersResponse{
+		Containers: containers,
+	}, nil
+}
+
+func (s *stateServiceServer) GetRecentEvents(ctx context.Context, req *api.GetRecentEventsRequest) (*api.GetRecentEventsResponse, error) {
+	if s.sensor.recentEvents == nil {
+		return nil, status.Error(codes.FailedPrecondition,
+			"Recent events are not being kept")
+	}
+
+	return &api.GetRecentEventsResponse{
+		Events: s.sensor.recentEvents.snapshot(req.ContainerId,
+			int(req.Limit)),
+	}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+func eventIDs(events []*api.Event) []string {
+	ids := make([]string, len(events))
+	for i, e := range events {
+		ids[i] = e.Id
+	}
+	return ids
+}
+
+func TestEventRing(t *testing.T) {
+	if r := newEventRing(0); r != nil {
+		t.Fatalf("Expected no ring for size 0, got %+v", r)
+	}
+	var none *eventRing
+	none.add(&api.Event{Id: "a"})
+	if got := none.snapshot("", 0); got != nil {
+		t.Errorf("Expected no events from nil ring, got %v", got)
+	}
+
+	r := newEventRing(3)
+	if got := r.snapshot("", 0); len(got) != 0 {
+		t.Errorf("Expected no events from empty ring, got %v", eventIDs(got))
+	}
+
+	r.add(&api.Event{Id: "1", ContainerId: "c1"})
+	r.add(&api.Event{Id: "2"})
+	if got := eventIDs(r.snapshot("", 0)); len(got) != 2 ||
+		got[0] != "1" || got[1] != "2" {
+		t.Errorf("Expected [1 2], got %v", got)
+	}
+
+	r.add(&api.Event{Id: "3", ContainerId: "c1"})
+	r.add(&api.Event{Id: "4", ContainerId: "c1"})
+
+	type testCase struct {
+		containerID string
+		limit       int
+		expected    []string
+	}
+	testCases := []testCase{
+		{"", 0, []string{"2", "3", "4"}},
+		{"", 2, []string{"3", "4"}},
+		{"", 5, []string{"2", "3", "4"}},
+		{"c1", 0, []string{"3", "4"}},
+		{"c1", 1, []string{"4"}},
+		{"c2", 0, nil},
+	}
+	for _, tc := range testCases {
+		got := eventIDs(r.snapshot(tc.containerID, tc.limit))
+		if len(got) != len(tc.expected) {
+			t.Errorf("snapshot(%q, %d): expected %v, got %v",
+				tc.containerID, tc.limit, tc.expected, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.expected[i] {
+				t.Errorf("snapshot(%q, %d): expected %v, got %v",
+					tc.containerID, tc.limit, tc.expected, got)
+				break
+			}
+		}
+	}
+}
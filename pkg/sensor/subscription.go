@@ -17,6 +17,8 @@ package sensor
 import (
 	"sync"
 	"sync/atomic"
+
+	api "github.com/capsule8/capsule8/api/v0"
 )
 
 type subscriptionUnregisterFn func(eventID uint64, sub *subscription)
@@ -24,6 +26,9 @@ type subscriptionUnregisterFn func(eventID uint64, sub *subscription)
 type subscription struct {
 	data       chan interface{}
 	unregister subscriptionUnregisterFn
+
+	// The name of the event source that registered the event
+	source string
 }
 
 //
@@ -95,3 +100,62 @@ func (m *safeSubscriptionMap) update(mfrom subscriptionMap) {
 
 	m.active.Store(nm)
 }
+
+// subscriptionStatus tracks which event sources were attached for a
+// subscription and how many of its events were sent or removed by its
+// modifiers. A nil *subscriptionStatus may be used when status isn't
+// needed.
+type subscriptionStatus struct {
+	sync.Mutex
+	sources []*api.EventSourceStatus
+
+	matched uint64 // accessed atomically
+	sent    uint64 // accessed atomically
+
+	// Closed when the sensor is stopping
+	sensorStopping <-chan struct{}
+}
+
+func (st *subscriptionStatus) addSource(name string, kernelEvents int, err string) {
+	if st == nil {
+		return
+	}
+
+	st.Lock()
+	st.sources = append(st.sources, &api.EventSourceStatus{
+		Name:         name,
+		KernelEvents: uint32(kernelEvents),
+		Error:        err,
+	})
+	st.Unlock()
+}
+
+// snapshot returns the current status of the subscription
+func (st *subscriptionStatus) snapshot() *api.SubscriptionStatus {
+	if st == nil {
+		return nil
+	}
+
+	st.Lock()
+	sources := make([]*api.EventSourceStatus, len(st.sources))
+	copy(sources, st.sources)
+	st.Unlock()
+
+	// Load sent before matched so that dropped can't go negative
+	sent := atomic.LoadUint64(&st.sent)
+	matched := atomic.LoadUint64(&st.matched)
+
+	stopping := false
+	select {
+	case <-st.sensorStopping:
+		stopping = true
+	default:
+	}
+
+	return &api.SubscriptionStatus{
+		Sources:        sources,
+		Events:         sent,
+		DroppedEvents:  matched - sent,
+		SensorStopping: stopping,
+	}
+}
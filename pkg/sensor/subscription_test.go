@@ -0,0 +1,60 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+)
+
+func TestSubscriptionStatus(t *testing.T) {
+	// A nil status silently discards sources and has no snapshot
+	var nilStatus *subscriptionStatus
+	nilStatus.addSource("file", 1, "")
+	if s := nilStatus.snapshot(); s != nil {
+		t.Errorf("Expected no snapshot of a nil status, got %+v", s)
+	}
+
+	st := &subscriptionStatus{}
+	st.addSource("file", 2, "")
+	st.addSource("network", 0, "No kernel events could be attached")
+	st.matched = 10
+	st.sent = 4
+
+	s := st.snapshot()
+	if len(s.Sources) != 2 {
+		t.Fatalf("Expected 2 sources, got %d", len(s.Sources))
+	}
+	if s.Sources[0].Name != "file" || s.Sources[0].KernelEvents != 2 ||
+		len(s.Sources[0].Error) != 0 {
+		t.Errorf("Unexpected file source status %+v", s.Sources[0])
+	}
+	if s.Sources[1].Name != "network" || len(s.Sources[1].Error) == 0 {
+		t.Errorf("Unexpected network source status %+v", s.Sources[1])
+	}
+	if s.Events != 4 || s.DroppedEvents != 6 {
+		t.Errorf("Expected 4 events and 6 dropped, got %d and %d",
+			s.Events, s.DroppedEvents)
+	}
+	if s.SensorStopping {
+		t.Error("Expected sensor not to be stopping")
+	}
+
+	stopping := make(chan struct{})
+	st.sensorStopping = stopping
+	close(stopping)
+	if s = st.snapshot(); !s.SensorStopping {
+		t.Error("Expected sensor to be stopping")
+	}
+}
@@ -23,12 +23,20 @@ import (
 
 	"github.com/capsule8/capsule8/pkg/expression"
 	"github.com/capsule8/capsule8/pkg/sys/perf"
+	"github.com/capsule8/capsule8/pkg/validate"
 
 	"github.com/golang/glog"
 )
 
+// syscallAllArgs is the capture mask used when no specific syscall
+// arguments are requested.
+const syscallAllArgs uint8 = 0x3f
+
 type syscallFilter struct {
 	sensor *Sensor
+
+	// captureArgs is a bitmask of the enter event arguments to report.
+	captureArgs uint8
 }
 
 func (f *syscallFilter) decodeDummySysEnter(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
@@ -36,18 +44,34 @@ func (f *syscallFilter) decodeDummySysEnter(sample *perf.SampleRecord, data perf
 }
 
 func (f *syscallFilter) decodeSyscallTraceEnter(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
+	id := data["id"].(int64)
+	sev := &api.SyscallEvent{
+		Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER,
+		Id:   id,
+		Name: validate.SyscallNames[id],
+	}
+	if f.captureArgs&(1<<0) != 0 {
+		sev.Arg0 = data["arg0"].(uint64)
+	}
+	if f.captureArgs&(1<<1) != 0 {
+		sev.Arg1 = data["arg1"].(uint64)
+	}
+	if f.captureArgs&(1<<2) != 0 {
+		sev.Arg2 = data["arg2"].(uint64)
+	}
+	if f.captureArgs&(1<<3) != 0 {
+		sev.Arg3 = data["arg3"].(uint64)
+	}
+	if f.captureArgs&(1<<4) != 0 {
+		sev.Arg4 = data["arg4"].(uint64)
+	}
+	if f.captureArgs&(1<<5) != 0 {
+		sev.Arg5 = data["arg5"].(uint64)
+	}
+
 	ev := f.sensor.NewEventFromSample(sample, data)
 	ev.Event = &api.Event_Syscall{
-		Syscall: &api.SyscallEvent{
-			Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER,
-			Id:   data["id"].(int64),
-			Arg0: data["arg0"].(uint64),
-			Arg1: data["arg1"].(uint64),
-			Arg2: data["arg2"].(uint64),
-			Arg3: data["arg3"].(uint64),
-			Arg4: data["arg4"].(uint64),
-			Arg5: data["arg5"].(uint64),
-		},
+		Syscall: sev,
 	}
 
 	return ev, nil
@@ -55,10 +79,12 @@ func (f *syscallFilter) decodeSyscallTraceEnter(sample *perf.SampleRecord, data
 
 func (f *syscallFilter) decodeSysExit(sample *perf.SampleRecord, data perf.TraceEventSampleData) (interface{}, error) {
 	ev := f.sensor.NewEventFromSample(sample, data)
+	id := data["id"].(int64)
 	ev.Event = &api.Event_Syscall{
 		Syscall: &api.SyscallEvent{
 			Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_EXIT,
-			Id:   data["id"].(int64),
+			Id:   id,
+			Name: validate.SyscallNames[id],
 			Ret:  data["ret"].(int64),
 		},
 	}
@@ -94,6 +120,19 @@ func containsIDFilter(expr *api.Expression) bool {
 }
 
 func rewriteSyscallEventFilter(sef *api.SyscallEventFilter) {
+	if len(sef.Name) > 0 {
+		// Unknown names are left in place so that the filter can be
+		// rejected by the caller.
+		if id, ok := validate.SyscallNumbers[sef.Name]; ok {
+			newExpr := expression.Equal(
+				expression.Identifier("id"),
+				expression.Value(id))
+			sef.FilterExpression = expression.LogicalAnd(
+				newExpr, sef.FilterExpression)
+			sef.Name = ""
+		}
+	}
+
 	if sef.Id != nil {
 		newExpr := expression.Equal(
 			expression.Identifier("id"),
@@ -187,11 +226,18 @@ const (
 func registerSyscallEvents(sensor *Sensor, eventMap subscriptionMap, events []*api.SyscallEventFilter) {
 	enterFilters := make(map[string]bool)
 	exitFilters := make(map[string]bool)
+	var captureArgs uint8
 
 	for _, sef := range events {
 		// Translate deprecated fields into an expression
 		rewriteSyscallEventFilter(sef)
 
+		if len(sef.Name) > 0 {
+			glog.V(1).Infof("Unknown syscall name in syscall event filter: %s",
+				sef.Name)
+			continue
+		}
+
 		if !containsIDFilter(sef.FilterExpression) {
 			// No wildcard filters for now
 			continue
@@ -212,6 +258,14 @@ func registerSyscallEvents(sensor *Sensor, eventMap subscriptionMap, events []*a
 		switch sef.Type {
 		case api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER:
 			enterFilters[s] = true
+			if len(sef.CaptureArgs) == 0 {
+				captureArgs = syscallAllArgs
+			}
+			for _, arg := range sef.CaptureArgs {
+				if arg < 6 {
+					captureArgs |= 1 << arg
+				}
+			}
 		case api.SyscallEventType_SYSCALL_EVENT_TYPE_EXIT:
 			exitFilters[s] = true
 		default:
@@ -220,7 +274,8 @@ func registerSyscallEvents(sensor *Sensor, eventMap subscriptionMap, events []*a
 	}
 
 	f := syscallFilter{
-		sensor: sensor,
+		sensor:      sensor,
+		captureArgs: captureArgs,
 	}
 
 	if len(enterFilters) > 0 {
@@ -0,0 +1,77 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/validate"
+)
+
+func TestRewriteSyscallEventFilterName(t *testing.T) {
+	sef := &api.SyscallEventFilter{
+		Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER,
+		Name: "ptrace",
+	}
+	rewriteSyscallEventFilter(sef)
+	if sef.Name != "" {
+		t.Errorf("Expected name to be rewritten, got %q", sef.Name)
+	}
+	if !containsIDFilter(sef.FilterExpression) {
+		t.Error("Expected rewritten filter to contain an id filter")
+	}
+
+	sef = &api.SyscallEventFilter{
+		Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER,
+		Name: "no_such_syscall",
+	}
+	rewriteSyscallEventFilter(sef)
+	if sef.Name != "no_such_syscall" {
+		t.Errorf("Expected unknown name to be left in place, got %q", sef.Name)
+	}
+	if sef.FilterExpression != nil {
+		t.Error("Expected no filter expression for unknown name")
+	}
+}
+
+func TestValidateSubscriptionSyscallName(t *testing.T) {
+	sub := func(name string) *api.Subscription {
+		return &api.Subscription{
+			EventFilter: &api.EventFilter{
+				SyscallEvents: []*api.SyscallEventFilter{
+					&api.SyscallEventFilter{
+						Type: api.SyscallEventType_SYSCALL_EVENT_TYPE_ENTER,
+						Name: name,
+					},
+				},
+			},
+		}
+	}
+
+	for _, name := range []string{"ptrace", "clone3", "io_uring_setup", "pidfd_open"} {
+		if err := ValidateSubscription(sub(name)); err != nil {
+			t.Errorf("Unexpected error for syscall %s: %s", name, err)
+		}
+	}
+	if err := ValidateSubscription(sub("no_such_syscall")); err == nil {
+		t.Error("Expected error for unknown syscall name")
+	}
+
+	if id := validate.SyscallNumbers["clone3"]; id != 435 {
+		t.Errorf("Expected clone3 to be syscall 435, got %d", id)
+	}
+}
@@ -18,13 +18,23 @@ import (
 	"net"
 	"os"
 	"strings"
+	"time"
 
 	api "github.com/capsule8/capsule8/api/v0"
+	"github.com/capsule8/capsule8/pkg/config"
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
 
+	"golang.org/x/net/context"
 	"golang.org/x/sys/unix"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 // TelemetryService is a service that can be used with the ServiceManager to
@@ -103,31 +113,76 @@ func (ts *TelemetryService) Serve() error {
 	defer lis.Close()
 
 	// Start local gRPC service on listener
-	ts.server = grpc.NewServer()
+	ts.server = grpc.NewServer(
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    config.Sensor.ClientKeepaliveTime,
+			Timeout: config.Sensor.ClientKeepaliveTimeout,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             config.Sensor.ClientKeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+	)
 	t := &telemetryServiceServer{
 		sensor: ts.sensor,
 	}
 	api.RegisterTelemetryServiceServer(ts.server, t)
+	api.RegisterStateServiceServer(ts.server, &stateServiceServer{
+		sensor: ts.sensor,
+	})
+
+	// Standard gRPC health checking and server reflection, so that
+	// generic tools can probe and explore the API
+	hs := health.NewServer()
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	hs.SetServingStatus("capsule8.api.v0.TelemetryService",
+		healthpb.HealthCheckResponse_SERVING)
+	hs.SetServingStatus("capsule8.api.v0.StateService",
+		healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(ts.server, hs)
+	reflection.Register(ts.server)
 
 	return ts.server.Serve(lis)
 }
 
-// Stop will stop a running TelemetryService.
+// Stop will stop a running TelemetryService. Subscriptions are ended and
+// their subscribers are given until the configured shutdown timeout to
+// receive their remaining events before their connections are closed.
 func (ts *TelemetryService) Stop() {
-	ts.server.GracefulStop()
+	ts.sensor.StopSubscriptions()
+
+	done := make(chan struct{})
+	go func() {
+		ts.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(config.Sensor.ShutdownTimeout):
+		glog.Warning("Timed out waiting for gRPC subscribers, closing connections")
+		ts.server.Stop()
+	}
 }
 
 type telemetryServiceServer struct {
 	sensor *Sensor
 }
 
+func (t *telemetryServiceServer) GetSchema(ctx context.Context, req *api.GetSchemaRequest) (*api.GetSchemaResponse, error) {
+	return api.NewGetSchemaResponse()
+}
+
 func (t *telemetryServiceServer) GetEvents(req *api.GetEventsRequest, stream api.TelemetryService_GetEventsServer) error {
 	sub := req.Subscription
 
 	glog.V(1).Infof("GetEvents(%+v)", sub)
 
-	eventStream, err := t.sensor.NewSubscription(sub)
-	if err != nil {
+	eventStream, subStatus, err := t.sensor.newSubscription(sub)
+	if _, ok := err.(*InvalidSubscriptionError); ok {
+		glog.V(1).Infof("Rejecting subscription %+v: %s", sub, err)
+		return status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		glog.Errorf("Failed to get events for subscription %+v: %s",
 			sub, err.Error())
 		return err
@@ -139,6 +194,22 @@ func (t *telemetryServiceServer) GetEvents(req *api.GetEventsRequest, stream api
 		eventStream.Close()
 	}()
 
+	// Acknowledge the subscription before sending any events
+	err = stream.Send(&api.GetEventsResponse{
+		Status: subStatus.snapshot(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if sub.Modifier != nil && sub.Modifier.Batch != nil {
+		err = sendBatches(eventStream.Data, stream.Send, *sub.Modifier.Batch)
+		if err != nil {
+			return err
+		}
+		return sendFinalStatus(stream.Send, subStatus)
+	}
+
 sendLoop:
 	for {
 		ev, ok := <-eventStream.Data
@@ -157,9 +228,127 @@ sendLoop:
 			},
 		})
 		if err != nil {
-			break sendLoop
+			return err
+		}
+	}
+
+	return sendFinalStatus(stream.Send, subStatus)
+}
+
+// sendFinalStatus sends the status of a subscription once its event stream
+// has ended so that the subscriber can see the final event counts.
+func sendFinalStatus(send func(*api.GetEventsResponse) error, subStatus *subscriptionStatus) error {
+	return send(&api.GetEventsResponse{
+		Status: subStatus.snapshot(),
+	})
+}
+
+// eventBatch accumulates telemetry events until one of the limits in a
+// BatchModifier is reached.
+type eventBatch struct {
+	maxEvents int
+	maxBytes  int
+
+	events []*api.TelemetryEvent
+	size   int
+}
+
+func newEventBatch(mod api.BatchModifier) *eventBatch {
+	b := &eventBatch{
+		maxEvents: int(mod.MaxEvents),
+		maxBytes:  int(mod.MaxBytes),
+	}
+	if b.maxEvents <= 0 && b.maxBytes <= 0 && mod.MaxLatencyMs <= 0 {
+		b.maxEvents = 1
+	}
+	return b
+}
+
+// wouldOverflow returns true if adding an event of the given encoded size
+// would exceed the batch's byte limit.
+func (b *eventBatch) wouldOverflow(size int) bool {
+	return b.maxBytes > 0 && len(b.events) > 0 && b.size+size > b.maxBytes
+}
+
+func (b *eventBatch) add(te *api.TelemetryEvent, size int) {
+	b.events = append(b.events, te)
+	b.size += size
+}
+
+func (b *eventBatch) full() bool {
+	return (b.maxEvents > 0 && len(b.events) >= b.maxEvents) ||
+		(b.maxBytes > 0 && b.size >= b.maxBytes)
+}
+
+func (b *eventBatch) take() []*api.TelemetryEvent {
+	events := b.events
+	b.events = nil
+	b.size = 0
+	return events
+}
+
+// sendBatches reads events from data and passes them to send in batches
+// according to mod until data is closed or send fails. The error from send,
+// if any, is returned.
+func sendBatches(data <-chan interface{}, send func(*api.GetEventsResponse) error, mod api.BatchModifier) error {
+	batch := newEventBatch(mod)
+	latency := time.Duration(mod.MaxLatencyMs) * time.Millisecond
+
+	var (
+		timer   *time.Timer
+		timeout <-chan time.Time
+	)
+
+	flush := func() error {
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+			timeout = nil
 		}
+		if len(batch.events) == 0 {
+			return nil
+		}
+		return send(&api.GetEventsResponse{
+			Events: batch.take(),
+		})
 	}
 
-	return nil
+	for {
+		select {
+		case ev, ok := <-data:
+			if !ok {
+				return flush()
+			}
+
+			te := &api.TelemetryEvent{
+				Event: ev.(*api.Event),
+			}
+			size := proto.Size(te)
+
+			if batch.wouldOverflow(size) {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+
+			batch.add(te, size)
+			if len(batch.events) == 1 && latency > 0 {
+				timer = time.NewTimer(latency)
+				timeout = timer.C
+			}
+
+			if batch.full() {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+
+		case <-timeout:
+			timer = nil
+			timeout = nil
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
 }
@@ -0,0 +1,141 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sensor
+
+import (
+	"errors"
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventBatch(t *testing.T) {
+	b := newEventBatch(api.BatchModifier{})
+	b.add(&api.TelemetryEvent{}, 10)
+	if !b.full() {
+		t.Error("Expected batch with no limits to hold one event")
+	}
+
+	b = newEventBatch(api.BatchModifier{
+		MaxEvents: 3,
+		MaxBytes:  100,
+	})
+	b.add(&api.TelemetryEvent{}, 40)
+	b.add(&api.TelemetryEvent{}, 40)
+	if b.full() {
+		t.Error("Expected batch below limits not to be full")
+	}
+	if !b.wouldOverflow(40) {
+		t.Error("Expected third event to overflow byte limit")
+	}
+	b.add(&api.TelemetryEvent{}, 10)
+	if !b.full() {
+		t.Error("Expected batch at event limit to be full")
+	}
+
+	events := b.take()
+	if len(events) != 3 {
+		t.Errorf("Expected 3 events, got %d", len(events))
+	}
+	if len(b.events) != 0 || b.size != 0 {
+		t.Error("Expected batch to be empty after take")
+	}
+
+	b = newEventBatch(api.BatchModifier{
+		MaxBytes: 100,
+	})
+	if b.wouldOverflow(200) {
+		t.Error("Expected oversized event to fit in an empty batch")
+	}
+}
+
+func TestSendBatchesReturnsSendError(t *testing.T) {
+	data := make(chan interface{}, 2)
+	data <- &api.Event{Id: "1"}
+	data <- &api.Event{Id: "2"}
+	close(data)
+
+	sendErr := errors.New("client went away")
+	sends := 0
+	send := func(*api.GetEventsResponse) error {
+		sends++
+		return sendErr
+	}
+
+	err := sendBatches(data, send, api.BatchModifier{MaxEvents: 1})
+	if err != sendErr {
+		t.Errorf("Expected send error, got %v", err)
+	}
+	if sends != 1 {
+		t.Errorf("Expected sending to stop after the first failure, got %d sends", sends)
+	}
+
+	data = make(chan interface{}, 1)
+	data <- &api.Event{Id: "1"}
+	close(data)
+	sends = 0
+	err = sendBatches(data, send, api.BatchModifier{MaxEvents: 10})
+	if err != sendErr || sends != 1 {
+		t.Errorf("Expected error from final flush, got %v after %d sends",
+			err, sends)
+	}
+}
+
+func BenchmarkSendBatches(b *testing.B) {
+	data := make(chan interface{}, 1024)
+	go func() {
+		defer close(data)
+		for i := 0; i < b.N; i++ {
+			data <- &api.Event{
+				Id: "benchmark",
+				Event: &api.Event_Chargen{
+					Chargen: &api.ChargenEvent{
+						Index:      uint64(i),
+						Characters: "0123456789abcdef",
+					},
+				},
+			}
+		}
+	}()
+
+	send := func(*api.GetEventsResponse) error {
+		return nil
+	}
+
+	b.ReportAllocs()
+	sendBatches(data, send, api.BatchModifier{
+		MaxEvents: 100,
+	})
+}
+
+func TestGetEventsRejectsInvalidSubscription(t *testing.T) {
+	s := &Sensor{}
+	if _, err := s.NewSubscription(&api.Subscription{}); err == nil {
+		t.Error("Expected an error for a subscription with no event filter")
+	} else if _, ok := err.(*InvalidSubscriptionError); !ok {
+		t.Errorf("Expected *InvalidSubscriptionError, got %T", err)
+	}
+
+	ts := &telemetryServiceServer{sensor: s}
+	err := ts.GetEvents(&api.GetEventsRequest{
+		Subscription: &api.Subscription{},
+	}, nil)
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"github.com/golang/glog"
+)
+
+// HealthCheck is called by an AdminService to check the health of the
+// component it is serving. It returns an error describing why the
+// component is unhealthy, or nil.
+type HealthCheck func() error
+
+// MetricsCollector is called by an AdminService to write the current
+// metrics of the component it is serving.
+type MetricsCollector func(mw *MetricsWriter)
+
+// AdminService is a service that serves an HTTP administration endpoint
+// for a long-running component. It serves a health check on /healthz,
+// metrics in the Prometheus text format on /metrics, and runtime profiling
+// information on /debug/pprof/.
+type AdminService struct {
+	server *http.Server
+
+	address string
+
+	sync.Mutex
+	healthChecks []HealthCheck
+	collectors   []MetricsCollector
+	stopped      bool
+}
+
+// NewAdminService creates a new AdminService instance bound to a
+// specified address.
+func NewAdminService(address string) *AdminService {
+	return &AdminService{
+		address: address,
+	}
+}
+
+// AddHealthCheck adds a check to those run for each /healthz request. The
+// component is healthy if all of them pass.
+func (as *AdminService) AddHealthCheck(check HealthCheck) {
+	as.Lock()
+	as.healthChecks = append(as.healthChecks, check)
+	as.Unlock()
+}
+
+// AddMetrics adds a collector to those called for each /metrics request.
+func (as *AdminService) AddMetrics(collector MetricsCollector) {
+	as.Lock()
+	as.collectors = append(as.collectors, collector)
+	as.Unlock()
+}
+
+func (as *AdminService) handleHealth(w http.ResponseWriter, r *http.Request) {
+	as.Lock()
+	checks := as.healthChecks
+	as.Unlock()
+
+	for _, check := range checks {
+		if err := check(); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+	}
+	fmt.Fprintln(w, "ok")
+}
+
+func (as *AdminService) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	as.Lock()
+	collectors := as.collectors
+	as.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	mw := NewMetricsWriter(w)
+
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	mw.Gauge("go_goroutines", "Number of goroutines that currently exist.",
+		float64(runtime.NumGoroutine()))
+	mw.Gauge("go_memstats_heap_alloc_bytes",
+		"Number of heap bytes allocated and still in use.",
+		float64(ms.HeapAlloc))
+	mw.Counter("go_gc_count_total", "Number of completed GC cycles.",
+		float64(ms.NumGC))
+
+	for _, collector := range collectors {
+		collector(mw)
+	}
+}
+
+// Handler returns the HTTP handler for the AdminService's endpoints.
+func (as *AdminService) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", as.handleHealth)
+	mux.HandleFunc("/metrics", as.handleMetrics)
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return mux
+}
+
+// Name returns a human-readable name for an AdminService.
+func (as *AdminService) Name() string {
+	return "Admin HTTP endpoint"
+}
+
+// Serve runs an AdminService. It sets up the HTTP endpoint and services
+// requests until the service is stopped. It runs on the calling Goroutine.
+func (as *AdminService) Serve() error {
+	glog.V(1).Infof("Serving admin HTTP endpoints on %s", as.address)
+
+	server := &http.Server{
+		Addr:    as.address,
+		Handler: as.Handler(),
+	}
+
+	as.Lock()
+	if as.stopped {
+		as.Unlock()
+		return http.ErrServerClosed
+	}
+	as.server = server
+	as.Unlock()
+
+	err := server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		glog.Errorf("Admin HTTP error: %s", err)
+	}
+
+	return err
+}
+
+// Stop stops a running AdminService. If Serve hasn't been called yet, it
+// returns without serving.
+func (as *AdminService) Stop() {
+	as.Lock()
+	as.stopped = true
+	server := as.server
+	as.Unlock()
+
+	if server != nil {
+		server.Shutdown(context.Background())
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMetricsWriter(t *testing.T) {
+	var buf bytes.Buffer
+	mw := NewMetricsWriter(&buf)
+	mw.Counter("c_total", "A counter.", 3)
+	mw.Gauge("g", "A gauge.", 0.5)
+	mw.CounterVec("v_total", "A vector.", "source", map[string]float64{
+		"b": 2,
+		"a": 1,
+	})
+
+	expected := `# HELP c_total A counter.
+# TYPE c_total counter
+c_total 3
+# HELP g A gauge.
+# TYPE g gauge
+g 0.5
+# HELP v_total A vector.
+# TYPE v_total counter
+v_total{source="a"} 1
+v_total{source="b"} 2
+`
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, buf.String())
+	}
+}
+
+func get(t *testing.T, h http.Handler, path string) (int, string) {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+
+	body, err := ioutil.ReadAll(w.Result().Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w.Code, string(body)
+}
+
+func TestAdminService(t *testing.T) {
+	as := NewAdminService("")
+	h := as.Handler()
+
+	if code, body := get(t, h, "/healthz"); code != http.StatusOK || body != "ok\n" {
+		t.Errorf("Expected healthy, got %d %q", code, body)
+	}
+
+	var err error
+	as.AddHealthCheck(func() error { return err })
+	err = errors.New("broken")
+	if code, body := get(t, h, "/healthz"); code != http.StatusServiceUnavailable ||
+		!strings.Contains(body, "broken") {
+		t.Errorf("Expected unhealthy, got %d %q", code, body)
+	}
+
+	as.AddMetrics(func(mw *MetricsWriter) {
+		mw.Counter("test_total", "A test counter.", 7)
+	})
+	code, body := get(t, h, "/metrics")
+	if code != http.StatusOK ||
+		!strings.Contains(body, "\ngo_goroutines ") ||
+		!strings.Contains(body, "\ntest_total 7\n") {
+		t.Errorf("Unexpected metrics %d:\n%s", code, body)
+	}
+
+	if code, _ := get(t, h, "/debug/pprof/"); code != http.StatusOK {
+		t.Errorf("Expected pprof index, got %d", code)
+	}
+}
+
+func TestAdminServiceStopBeforeServe(t *testing.T) {
+	as := NewAdminService("127.0.0.1:0")
+	as.Stop()
+	if err := as.Serve(); err != http.ErrServerClosed {
+		t.Errorf("Expected %v, got %v", http.ErrServerClosed, err)
+	}
+}
+
+func TestProfilingService(t *testing.T) {
+	ps := NewProfilingService("")
+	if code, _ := get(t, ps.Handler(), "/debug/pprof/"); code != http.StatusOK {
+		t.Errorf("Expected pprof index, got %d", code)
+	}
+}
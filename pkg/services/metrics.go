@@ -0,0 +1,71 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MetricsWriter writes metrics in the Prometheus text exposition format.
+type MetricsWriter struct {
+	w io.Writer
+}
+
+// NewMetricsWriter creates a new MetricsWriter writing to w.
+func NewMetricsWriter(w io.Writer) *MetricsWriter {
+	return &MetricsWriter{w: w}
+}
+
+func (mw *MetricsWriter) header(name, help, metricType string) {
+	fmt.Fprintf(mw.w, "# HELP %s %s\n# TYPE %s %s\n", name,
+		strings.Replace(help, "\n", " ", -1), name, metricType)
+}
+
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// Counter writes a metric whose value only ever increases.
+func (mw *MetricsWriter) Counter(name, help string, value float64) {
+	mw.header(name, help, "counter")
+	fmt.Fprintf(mw.w, "%s %s\n", name, formatValue(value))
+}
+
+// Gauge writes a metric whose value may go up and down.
+func (mw *MetricsWriter) Gauge(name, help string, value float64) {
+	mw.header(name, help, "gauge")
+	fmt.Fprintf(mw.w, "%s %s\n", name, formatValue(value))
+}
+
+// CounterVec writes a counter with one sample for each value of the given
+// label.
+func (mw *MetricsWriter) CounterVec(name, help, label string, values map[string]float64) {
+	mw.header(name, help, "counter")
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fmt.Fprintf(mw.w, "%s{%s=%s} %s\n", name, label,
+			strconv.Quote(k), formatValue(values[k]))
+	}
+}
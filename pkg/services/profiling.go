@@ -14,57 +14,21 @@
 
 package services
 
-import (
-	"net/http"
-
-	// Include pprof
-	_ "net/http/pprof"
-
-	"golang.org/x/net/context"
-
-	"github.com/golang/glog"
-)
-
 // ProfilingService is a service that returns profiling information via a
 // HTTP server.
+//
+// Deprecated: Use AdminService, which serves the same profiling
+// information along with health checks and metrics.
 type ProfilingService struct {
-	server *http.Server
-
-	address string
+	*AdminService
 }
 
 // NewProfilingService creates a new ProfilingService instance bound to
 // a specified address.
+//
+// Deprecated: Use NewAdminService.
 func NewProfilingService(address string) *ProfilingService {
 	return &ProfilingService{
-		address: address,
-	}
-}
-
-// Name returns a human-readable name for a ProfilingService.
-func (ps *ProfilingService) Name() string {
-	return "Profiling HTTP endpoint"
-}
-
-// Serve runs a ProfilingService. It sets up the HTTP endpoint and services
-// requests until the service is stopped. It runs on the calling Goroutine.
-func (ps *ProfilingService) Serve() error {
-	glog.V(1).Infof("Serving profiling HTTP endpoints on %s",
-		ps.address)
-
-	ps.server = &http.Server{
-		Addr: ps.address,
+		AdminService: NewAdminService(address),
 	}
-
-	err := ps.server.ListenAndServe()
-	if err != nil {
-		glog.Errorf("Profiling HTTP error: %s", err)
-	}
-
-	return err
-}
-
-// Stop stops a running ProfilingService.
-func (ps *ProfilingService) Stop() {
-	ps.server.Shutdown(context.Background())
 }
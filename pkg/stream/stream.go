@@ -27,6 +27,7 @@ package stream
 
 import (
 	"math"
+	"math/rand"
 	"reflect"
 	"time"
 	"unicode/utf8"
@@ -606,10 +607,7 @@ func OnOffValve(in *Stream) (*Stream, chan<- bool) {
 	return s, ctrl
 }
 
-// Throttle limits the number of events emitted by the stream
-func Throttle(in *Stream, mod api.ThrottleModifier) *Stream {
-	data := make(chan interface{})
-
+func throttleInterval(mod api.ThrottleModifier) time.Duration {
 	// Convert `IntervalType` to a `Duration`
 	var interval time.Duration
 	switch mod.IntervalType {
@@ -623,6 +621,14 @@ func Throttle(in *Stream, mod api.ThrottleModifier) *Stream {
 		interval = time.Hour
 	}
 
+	return time.Duration(mod.Interval) * interval
+}
+
+// Throttle limits the number of events emitted by the stream
+func Throttle(in *Stream, mod api.ThrottleModifier) *Stream {
+	data := make(chan interface{})
+	interval := throttleInterval(mod)
+
 	go func() {
 		defer close(data)
 
@@ -635,7 +641,142 @@ func Throttle(in *Stream, mod api.ThrottleModifier) *Stream {
 					return
 				}
 			}
-			time.Sleep(time.Duration(mod.Interval) * interval)
+			time.Sleep(interval)
+		}
+	}()
+
+	return &Stream{
+		Ctrl: in.Ctrl,
+		Data: data,
+	}
+}
+
+// KeyFunc is the signature of a function that is called by ThrottleByKey to
+// group the elements of a stream.
+type KeyFunc func(interface{}) string
+
+// ThrottleByKey passes at most one element per key during each throttle
+// interval. Unlike Throttle, elements over the limit are discarded rather
+// than delayed.
+func ThrottleByKey(in *Stream, mod api.ThrottleModifier, key KeyFunc) *Stream {
+	interval := throttleInterval(mod)
+	lastSent := make(map[string]time.Time)
+	lastPruned := time.Now()
+
+	return Filter(in, func(e interface{}) bool {
+		now := time.Now()
+
+		// Forget keys that haven't been seen for a full interval so
+		// that the map doesn't grow without bound.
+		if now.Sub(lastPruned) >= interval {
+			for k, t := range lastSent {
+				if now.Sub(t) >= interval {
+					delete(lastSent, k)
+				}
+			}
+			lastPruned = now
+		}
+
+		k := key(e)
+		if t, ok := lastSent[k]; ok && now.Sub(t) < interval {
+			return false
+		}
+		lastSent[k] = now
+		return true
+	})
+}
+
+// Sample randomly discards elements from the stream so that, on average,
+// only the fraction specified by the modifier's rate is passed.
+func Sample(in *Stream, mod api.SampleModifier) *Stream {
+	rate := mod.Rate
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	return Filter(in, func(e interface{}) bool {
+		return r.Float64() < rate
+	})
+}
+
+// MergeFunc is the signature of a function that is called by Coalesce to
+// merge count elements with the same key, the first and last of which are
+// given, into the single element to pass on. It must not modify first or
+// last.
+type MergeFunc func(first, last interface{}, count uint64) interface{}
+
+type coalescedElement struct {
+	first    interface{}
+	last     interface{}
+	count    uint64
+	deadline time.Time
+}
+
+// Coalesce holds each element for the modifier's window, merging any
+// elements with the same key that arrive during that time into it with
+// the given merge function. Elements are passed on in the order in which
+// the first element with each key arrived. An element with no others
+// sharing its key is passed on unchanged.
+func Coalesce(in *Stream, mod api.CoalesceModifier, key KeyFunc, merge MergeFunc) *Stream {
+	data := make(chan interface{})
+	window := time.Duration(mod.WindowMs) * time.Millisecond
+
+	tick := window / 4
+	if tick < time.Millisecond {
+		tick = time.Millisecond
+	}
+
+	go func() {
+		defer close(data)
+
+		pending := make(map[string]*coalescedElement)
+		var order []string
+
+		// Every element is held for the same window, so deadlines
+		// are in arrival order.
+		flush := func(now time.Time, all bool) {
+			n := 0
+			for ; n < len(order); n++ {
+				c := pending[order[n]]
+				if !all && now.Before(c.deadline) {
+					break
+				}
+				delete(pending, order[n])
+				if c.count > 1 {
+					data <- merge(c.first, c.last, c.count)
+				} else {
+					data <- c.first
+				}
+			}
+			order = order[n:]
+		}
+
+		ticker := time.NewTicker(tick)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case e, ok := <-in.Data:
+				if !ok {
+					flush(time.Time{}, true)
+					return
+				}
+
+				k := key(e)
+				if c, ok := pending[k]; ok {
+					c.last = e
+					c.count++
+					continue
+				}
+				pending[k] = &coalescedElement{
+					first:    e,
+					last:     e,
+					count:    1,
+					deadline: time.Now().Add(window),
+				}
+				order = append(order, k)
+
+			case now := <-ticker.C:
+				flush(now, false)
+			}
 		}
 	}()
 
@@ -678,6 +819,71 @@ func Limit(in *Stream, mod api.LimitModifier) *Stream {
 	}
 }
 
+// Until ends the stream once the specified duration has elapsed
+func Until(in *Stream, d time.Duration) *Stream {
+	data := make(chan interface{})
+
+	go func() {
+		defer close(data)
+
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		for {
+			select {
+			case e, ok := <-in.Data:
+				if !ok {
+					return
+				}
+				select {
+				case data <- e:
+				case <-timer.C:
+					return
+				}
+			case <-timer.C:
+				return
+			}
+		}
+	}()
+
+	return &Stream{
+		Ctrl: in.Ctrl,
+		Data: data,
+	}
+}
+
+// UntilDone ends the stream once the done channel is closed. Unlike closing
+// the stream's Ctrl channel, this lets the operators after it drain and
+// pass on any elements they are holding.
+func UntilDone(in *Stream, done <-chan struct{}) *Stream {
+	data := make(chan interface{})
+
+	go func() {
+		defer close(data)
+
+		for {
+			select {
+			case e, ok := <-in.Data:
+				if !ok {
+					return
+				}
+				select {
+				case data <- e:
+				case <-done:
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return &Stream{
+		Ctrl: in.Ctrl,
+		Data: data,
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Terminators accept an input stream and return a terminal value. They are
 // typically used to aggregate a value over the entire stream.
@@ -14,7 +14,13 @@
 
 package stream
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
 
 func TestNext(t *testing.T) {
 	s := Iota(10)
@@ -123,3 +129,162 @@ func TestIota3(t *testing.T) {
 		t.Errorf("Expected total = %d, got %d\n", expected, total)
 	}
 }
+
+func TestThrottleByKey(t *testing.T) {
+	s := Iota(10)
+	defer s.Close()
+
+	// Even and odd numbers are throttled independently, so only 0 and 1
+	// should make it through a one hour throttle.
+	s = ThrottleByKey(s, api.ThrottleModifier{
+		Interval:     1,
+		IntervalType: api.ThrottleModifier_HOUR,
+	}, func(e interface{}) string {
+		if e.(uint64)%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+
+	v := Reduce(s, uint64(0), func(a interface{}, b interface{}) interface{} {
+		return a.(uint64) + b.(uint64) + 1
+	})
+
+	i := <-v
+	if i.(uint64) != 3 {
+		t.Errorf("Expected elements 0 and 1, got sum+count %d", i.(uint64))
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	s := Iota(10)
+	defer s.Close()
+
+	// Even and odd numbers are coalesced separately. The window is long
+	// enough that everything is merged when the input ends.
+	s = Coalesce(s, api.CoalesceModifier{
+		WindowMs: 3600000,
+	}, func(e interface{}) string {
+		if e.(uint64)%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}, func(first, last interface{}, count uint64) interface{} {
+		return fmt.Sprintf("%d-%d/%d", first, last, count)
+	})
+
+	v := Reduce(s, "", func(a interface{}, b interface{}) interface{} {
+		return a.(string) + fmt.Sprintf("%v ", b)
+	})
+
+	i := <-v
+	if i.(string) != "0-8/5 1-9/5 " {
+		t.Errorf("Expected \"0-8/5 1-9/5 \", got %q", i.(string))
+	}
+
+	// Elements with distinct keys are passed on unchanged, in order,
+	// once their window expires.
+	s = Iota(3)
+	defer s.Close()
+
+	s = Coalesce(s, api.CoalesceModifier{
+		WindowMs: 1,
+	}, func(e interface{}) string {
+		return fmt.Sprintf("%d", e)
+	}, func(first, last interface{}, count uint64) interface{} {
+		t.Errorf("Unexpected merge of %v and %v", first, last)
+		return first
+	})
+
+	v = Reduce(s, uint64(0), func(a interface{}, b interface{}) interface{} {
+		return a.(uint64)*10 + b.(uint64) + 1
+	})
+
+	i = <-v
+	if i.(uint64) != 123 {
+		t.Errorf("Expected elements 0, 1 and 2 in order, got %d", i.(uint64))
+	}
+}
+
+func TestSample(t *testing.T) {
+	s := Iota(100)
+	defer s.Close()
+
+	s = Sample(s, api.SampleModifier{
+		Rate: 1,
+	})
+
+	v := Reduce(s, 0, func(a interface{}, b interface{}) interface{} {
+		return a.(int) + 1
+	})
+
+	i := <-v
+	if i.(int) != 100 {
+		t.Errorf("Expected all 100 elements with rate 1, got %d", i.(int))
+	}
+}
+
+func TestUntil(t *testing.T) {
+	s := Ticker(time.Millisecond)
+	defer s.Close()
+
+	s = Until(s, 50*time.Millisecond)
+
+	select {
+	case <-Wait(s):
+	case <-time.After(5 * time.Second):
+		t.Error("Expected stream to end after its duration elapsed")
+	}
+}
+
+func TestUntilDone(t *testing.T) {
+	done := make(chan struct{})
+	s := UntilDone(Ticker(time.Millisecond), done)
+	defer s.Close()
+
+	if _, ok := <-s.Data; !ok {
+		t.Fatal("Expected element before done was closed")
+	}
+
+	close(done)
+
+	select {
+	case <-Wait(s):
+	case <-time.After(5 * time.Second):
+		t.Error("Expected stream to end after done was closed")
+	}
+}
+
+func BenchmarkFilterMap(b *testing.B) {
+	s := Iota(uint64(b.N))
+	defer s.Close()
+
+	s = Filter(s, func(e interface{}) bool {
+		return e.(uint64)%2 == 0
+	})
+	s = Map(s, func(e interface{}) interface{} {
+		return e.(uint64) * 2
+	})
+
+	b.ReportAllocs()
+	<-Wait(s)
+}
+
+func BenchmarkCoalesce(b *testing.B) {
+	s := Iota(uint64(b.N))
+	defer s.Close()
+
+	s = Coalesce(s, api.CoalesceModifier{
+		WindowMs: 10,
+	}, func(e interface{}) string {
+		if e.(uint64)%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}, func(first, last interface{}, count uint64) interface{} {
+		return first
+	})
+
+	b.ReportAllocs()
+	<-Wait(s)
+}
@@ -170,7 +170,7 @@ func findHostProcFS() *proc.FileSystem {
 	}
 
 	for _, cg := range initCgroups {
-		if cg.Path == "/" {
+		if cg.IsRoot() {
 			// /proc is a host procfs, return it
 			return procFS
 		}
@@ -190,7 +190,7 @@ func findHostProcFS() *proc.FileSystem {
 				}
 
 				for _, cg := range initCgroups {
-					if cg.Path == "/" {
+					if cg.IsRoot() {
 						return &fs
 					}
 				}
@@ -233,7 +233,10 @@ func TracingDir() string {
 }
 
 // PerfEventDir returns the mountpoint of the perf_event cgroup
-// pseudo-filesystem or an empty string if it wasn't found.
+// pseudo-filesystem or an empty string if it wasn't found. On hybrid
+// systems that have both a perf_event cgroup v1 hierarchy and a unified
+// cgroup v2 hierarchy, the v1 hierarchy is preferred. If there is no
+// perf_event v1 hierarchy, the unified hierarchy is used.
 func PerfEventDir() string {
 	for _, mi := range Mounts() {
 		if mi.FilesystemType == "cgroup" {
@@ -245,6 +248,18 @@ func PerfEventDir() string {
 		}
 	}
 
+	return Cgroup2Dir()
+}
+
+// Cgroup2Dir returns the mountpoint of the unified (cgroup v2)
+// pseudo-filesystem or an empty string if it wasn't found.
+func Cgroup2Dir() string {
+	for _, mi := range Mounts() {
+		if mi.FilesystemType == "cgroup2" {
+			return mi.MountPoint
+		}
+	}
+
 	return ""
 }
 
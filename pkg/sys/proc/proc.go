@@ -32,12 +32,16 @@ import (
 )
 
 //
-// Docker cgroup paths may look like either of:
+// Container cgroup paths may look like any of:
 // - /docker/[CONTAINER_ID]
 // - /kubepods/[...]/[CONTAINER_ID]
 // - /system.slice/docker-[CONTAINER_ID].scope
+// - /kubepods.slice/[...]/(docker|cri-containerd|crio)-[CONTAINER_ID].scope
 //
-const cgroupContainerPattern = "^(/docker/|/kubepods/.*/|/system.slice/docker-)([[:xdigit:]]{64})(.scope|$)"
+// The last form is created by the systemd cgroup driver, which is the
+// usual layout on unified (cgroup v2) hierarchies.
+//
+const cgroupContainerPattern = "^(/docker/|/kubepods/.*/|/system.slice/docker-|/kubepods.slice/.*/(?:docker|cri-containerd|crio)-)([[:xdigit:]]{64})(.scope|$)"
 
 var (
 	// Default procfs mounted on /proc
@@ -117,6 +121,29 @@ func (fs *FileSystem) ReadFile(relativePath string) ([]byte, error) {
 	return ioutil.ReadFile(filepath.Join(fs.MountPoint, relativePath))
 }
 
+// Pids returns the PIDs of the processes currently visible in the procfs.
+func (fs *FileSystem) Pids() ([]int, error) {
+	f, err := os.Open(fs.MountPoint)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	names, err := f.Readdirnames(-1)
+	if err != nil {
+		return nil, err
+	}
+
+	var pids []int
+	for _, name := range names {
+		pid, err := strconv.Atoi(name)
+		if err == nil && pid > 0 {
+			pids = append(pids, pid)
+		}
+	}
+	return pids, nil
+}
+
 // CommandLine gets the full command-line arguments for the process
 // indicated by the given PID.
 func CommandLine(pid int) []string {
@@ -181,15 +208,25 @@ func parseProcPidCgroup(cgroup []byte) []Cgroup {
 	scanner := bufio.NewScanner(bytes.NewReader(cgroup))
 	for scanner.Scan() {
 		t := scanner.Text()
-		parts := strings.Split(t, ":")
+		// The path may itself contain colons, so only split off the
+		// hierarchy ID and controllers.
+		parts := strings.SplitN(t, ":", 3)
+		if len(parts) < 3 {
+			glog.Fatalf("Couldn't parse cgroup line: %s", t)
+		}
 		ID, err := strconv.Atoi(parts[0])
 		if err != nil {
 			glog.Fatalf("Couldn't parse cgroup line: %s", t)
 		}
 
+		var controllers []string
+		if len(parts[1]) > 0 {
+			controllers = strings.Split(parts[1], ",")
+		}
+
 		c := Cgroup{
 			ID:          ID,
-			Controllers: strings.Split(parts[1], ","),
+			Controllers: controllers,
 			Path:        parts[2],
 		}
 
@@ -201,10 +238,12 @@ func parseProcPidCgroup(cgroup []byte) []Cgroup {
 
 // Cgroup describes the cgroup membership of a process
 type Cgroup struct {
-	// Unique hierarchy ID
+	// Unique hierarchy ID. This is 0 for the unified (cgroup v2)
+	// hierarchy.
 	ID int
 
-	// Cgroup controllers (subsystems) bound to the hierarchy
+	// Cgroup controllers (subsystems) bound to the hierarchy. This is
+	// empty for the unified (cgroup v2) hierarchy.
 	Controllers []string
 
 	// Path is the pathname of the control group to which the process
@@ -212,6 +251,13 @@ type Cgroup struct {
 	Path string
 }
 
+// IsRoot returns true if the cgroup is the root of its hierarchy. On the
+// unified hierarchy, systemd places init in /init.scope rather than the
+// root, so that is treated as the root as well.
+func (c Cgroup) IsRoot() bool {
+	return c.Path == "/" || (c.ID == 0 && c.Path == "/init.scope")
+}
+
 // ContainerID returns the container ID running the process indicated
 // by the given PID. Returns the empty string if the process is not
 // running within a container. Returns a non-nil error if the process
@@ -264,6 +310,12 @@ func (fs *FileSystem) UniqueID(pid int) string {
 	return ps.UniqueID()
 }
 
+// PidNamespace returns the identifier of the pid namespace of the process
+// indicated by the given PID, as in "pid:[4026531836]".
+func (fs *FileSystem) PidNamespace(pid int) (string, error) {
+	return os.Readlink(filepath.Join(fs.MountPoint, strconv.Itoa(pid), "ns", "pid"))
+}
+
 // Stat reads the given process's status and returns a ProcessStatus
 // with methods to parse and return information from that status as
 // needed.
@@ -15,6 +15,10 @@
 package proc
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
 	"testing"
 )
 
@@ -161,6 +165,14 @@ var cgroupTests = []struct {
 2:freezer:/
 1:name=systemd:/user.slice/user-1000.slice/session-5.scope
 0::/user.slice/user-1000.slice/session-5.scope
+`, ""},
+	{`0::/system.slice/docker-47490dda5cd7e409e7bf04a8b291f87f15031090a955dac9ceed6a2160474d81.scope
+`, "47490dda5cd7e409e7bf04a8b291f87f15031090a955dac9ceed6a2160474d81"},
+	{`0::/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-poddbcfa688_dad5_11e7_a0e9_02e725baeeac.slice/cri-containerd-22d8b77a1a9a6217710e3f2808c69263c674f31aa615484f808831203111e622.scope
+`, "22d8b77a1a9a6217710e3f2808c69263c674f31aa615484f808831203111e622"},
+	{`0::/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-poddbcfa688_dad5_11e7_a0e9_02e725baeeac.slice/crio-22d8b77a1a9a6217710e3f2808c69263c674f31aa615484f808831203111e622.scope
+`, "22d8b77a1a9a6217710e3f2808c69263c674f31aa615484f808831203111e622"},
+	{`0::/init.scope
 `, ""},
 }
 
@@ -174,3 +186,51 @@ func TestCgroupParse(t *testing.T) {
 		}
 	}
 }
+
+func TestPids(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"1", "42", "self", "sys"} {
+		if err = os.Mkdir(filepath.Join(dir, name), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs := &FileSystem{MountPoint: dir}
+	pids, err := fs.Pids()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Ints(pids)
+	if len(pids) != 2 || pids[0] != 1 || pids[1] != 42 {
+		t.Errorf("Expected pids [1 42], got %v", pids)
+	}
+}
+
+func TestPidNamespace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err = os.MkdirAll(filepath.Join(dir, "42", "ns"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	want := "pid:[4026531836]"
+	if err = os.Symlink(want, filepath.Join(dir, "42", "ns", "pid")); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := &FileSystem{MountPoint: dir}
+	if ns, err := fs.PidNamespace(42); err != nil || ns != want {
+		t.Errorf("Expected %s, got %q (%v)", want, ns, err)
+	}
+	if _, err := fs.PidNamespace(43); err == nil {
+		t.Error("Expected error for missing process")
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validate checks telemetry subscriptions without depending on the
+// rest of the Sensor, so that clients can validate a subscription before
+// sending it.
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	api "github.com/capsule8/capsule8/api/v0"
+
+	"github.com/capsule8/capsule8/pkg/expression"
+)
+
+// EventKeys maps the Event field names that may be used as a
+// ThrottleModifier key to functions returning that field.
+var EventKeys = map[string]func(*api.Event) string{
+	"container_id": func(e *api.Event) string {
+		return e.ContainerId
+	},
+	"image_id": func(e *api.Event) string {
+		return e.ImageId
+	},
+	"process_id": func(e *api.Event) string {
+		return e.ProcessId
+	},
+	"sensor_id": func(e *api.Event) string {
+		return e.SensorId
+	},
+}
+
+func validateFilterExpression(kind string, tree *api.Expression, kernel bool) error {
+	if tree == nil {
+		return nil
+	}
+
+	expr, err := expression.NewExpression(tree)
+	if err != nil {
+		return fmt.Errorf("Invalid %s filter expression: %s", kind, err)
+	}
+	if kernel {
+		err = expr.ValidateKernelFilter()
+		if err != nil {
+			return fmt.Errorf("Invalid %s filter expression: %s", kind, err)
+		}
+	}
+
+	return nil
+}
+
+// Subscription checks that all of the filter expressions in the given
+// api.Subscription descriptor are well-formed and, for events that are
+// filtered in the kernel, can be used as kernel filters. Modifier parameters
+// are also checked. The first problem found is returned as an error.
+func Subscription(sub *api.Subscription) error {
+	if sub == nil || sub.EventFilter == nil {
+		return errors.New("Subscription has no event filter")
+	}
+
+	ef := sub.EventFilter
+	for _, f := range ef.SyscallEvents {
+		if err := validateFilterExpression("syscall event", f.FilterExpression, true); err != nil {
+			return err
+		}
+		if _, ok := SyscallNumbers[f.Name]; len(f.Name) > 0 && !ok {
+			return fmt.Errorf("Unknown syscall name %q", f.Name)
+		}
+	}
+	for _, f := range ef.ProcessEvents {
+		if err := validateFilterExpression("process event", f.FilterExpression, true); err != nil {
+			return err
+		}
+	}
+	for _, f := range ef.FileEvents {
+		if err := validateFilterExpression("file event", f.FilterExpression, true); err != nil {
+			return err
+		}
+		for _, p := range f.PathPatterns {
+			if err := validatePathPattern(p); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ef.KernelEvents {
+		if err := validateFilterExpression("kernel function call", f.FilterExpression, true); err != nil {
+			return err
+		}
+	}
+	for _, f := range ef.NetworkEvents {
+		if err := validateFilterExpression("network event", f.FilterExpression, true); err != nil {
+			return err
+		}
+	}
+	for _, f := range ef.KernelLoadEvents {
+		if err := validateFilterExpression("kernel load event", f.FilterExpression, true); err != nil {
+			return err
+		}
+	}
+	for _, f := range ef.MemoryEvents {
+		if err := validateFilterExpression("memory event", f.FilterExpression, true); err != nil {
+			return err
+		}
+	}
+	for _, f := range ef.PrivilegeEvents {
+		if err := validateFilterExpression("privilege event", f.FilterExpression, true); err != nil {
+			return err
+		}
+	}
+	for _, f := range ef.ContainerEvents {
+		if err := validateFilterExpression("container event", f.FilterExpression, false); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range ef.ChargenEvents {
+		if f.Length == 0 {
+			return errors.New("Chargen event filter length must be non-zero")
+		}
+	}
+
+	if sub.Modifier != nil {
+		if t := sub.Modifier.Throttle; t != nil && len(t.Key) > 0 {
+			if _, ok := EventKeys[t.Key]; !ok {
+				return fmt.Errorf("Invalid throttle key %q", t.Key)
+			}
+		}
+		if sm := sub.Modifier.Sample; sm != nil {
+			if sm.Rate <= 0 || sm.Rate > 1 {
+				return fmt.Errorf("Invalid sample rate %v", sm.Rate)
+			}
+		}
+		if c := sub.Modifier.Coalesce; c != nil && c.WindowMs <= 0 {
+			return fmt.Errorf("Invalid coalesce window %d", c.WindowMs)
+		}
+	}
+
+	if sub.ForDuration != nil && sub.ForDuration.Value <= 0 {
+		return fmt.Errorf("Invalid subscription duration %d",
+			sub.ForDuration.Value)
+	}
+
+	return nil
+}
+
+// validatePathPattern checks that a file event filter's path pattern can be
+// matched by the kernel, which only accepts wildcards at either end.
+func validatePathPattern(pattern string) error {
+	p := strings.TrimSuffix(strings.TrimPrefix(pattern, "*"), "*")
+	if len(p) == 0 {
+		return fmt.Errorf("Invalid path pattern %q: nothing to match", pattern)
+	}
+	if strings.Contains(p, "*") {
+		return fmt.Errorf("Invalid path pattern %q: * may only begin or end a pattern",
+			pattern)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2017 Capsule8, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"testing"
+
+	api "github.com/capsule8/capsule8/api/v0"
+)
+
+func TestValidatePathPattern(t *testing.T) {
+	for _, p := range []string{"/etc/passwd", "/etc/*", "*/.ssh/*", "*.so"} {
+		if err := validatePathPattern(p); err != nil {
+			t.Errorf("Expected %q to be valid: %s", p, err)
+		}
+	}
+	for _, p := range []string{"", "*", "**", "/etc/*/passwd"} {
+		if err := validatePathPattern(p); err == nil {
+			t.Errorf("Expected %q to be invalid", p)
+		}
+	}
+
+	sub := &api.Subscription{
+		EventFilter: &api.EventFilter{
+			FileEvents: []*api.FileEventFilter{
+				&api.FileEventFilter{
+					Type:         api.FileEventType_FILE_EVENT_TYPE_OPEN,
+					PathPatterns: []string{"/usr/*/bin"},
+				},
+			},
+		},
+	}
+	if err := Subscription(sub); err == nil {
+		t.Error("Expected subscription with invalid path pattern to be rejected")
+	}
+}